@@ -1,12 +1,13 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.27.1
+// 	protoc-gen-go v1.30.0
 // 	protoc        (unknown)
 // source: uuid/v1/uuid.proto
 
 package uuidv1
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -20,11 +21,205 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ErrorCode why an rpc failed. A failed rpc answers with a grpc status of the code next to each value and an
+// ErrorDetail, over http with the matching http status and the code in the error field of the body.
+// 10001 to 10004 keep the ecodes the server answered with before the codes were typed
+type ErrorCode int32
+
+const (
+	// not an error
+	ErrorCode_ERROR_CODE_UNSPECIFIED ErrorCode = 0
+	// NOT_FOUND, the prefix of the public id is not registered
+	ErrorCode_ERROR_CODE_UNKNOWN_PUBLIC_ID_PREFIX ErrorCode = 10001
+	// INVALID_ARGUMENT, the check character of the public id does not match, it was most likely mistyped
+	ErrorCode_ERROR_CODE_PUBLIC_ID_CHECKSUM_MISMATCH ErrorCode = 10002
+	// NOT_FOUND, the key version of the obfuscated id is not configured, its key may have been dropped
+	ErrorCode_ERROR_CODE_UNKNOWN_OBFUSCATION_KEY_VERSION ErrorCode = 10003
+	// ALREADY_EXISTS, the idempotency key was used for a request asking for other ids
+	ErrorCode_ERROR_CODE_IDEMPOTENCY_KEY_REUSED ErrorCode = 10004
+	// INVALID_ARGUMENT, a query param or the body of an http request does not match the request message
+	ErrorCode_ERROR_CODE_INVALID_REQUEST ErrorCode = 10005
+	// INTERNAL, the server failed in a way it does not tell apart
+	ErrorCode_ERROR_CODE_INTERNAL ErrorCode = 10006
+	// NOT_FOUND, no rpc is annotated with the path and method of an http request
+	ErrorCode_ERROR_CODE_UNKNOWN_ROUTE ErrorCode = 10007
+	// UNAVAILABLE, the node id lease of the server could not be renewed in time
+	ErrorCode_ERROR_CODE_NODE_LEASE_EXPIRED ErrorCode = 10008
+	// RESOURCE_EXHAUSTED, every node id is held by a live instance or client
+	ErrorCode_ERROR_CODE_NODE_ID_EXHAUSTED ErrorCode = 10009
+	// ABORTED, the clock is behind the last id issued and the clock rollback policy refused to generate
+	ErrorCode_ERROR_CODE_CLOCK_ROLLBACK ErrorCode = 10010
+	// FAILED_PRECONDITION, the clock is not past the last id a previous run of the node id may have issued
+	ErrorCode_ERROR_CODE_CLOCK_BEHIND_HIGH_WATER_MARK ErrorCode = 10011
+	// NOT_FOUND, the generator is not configured
+	ErrorCode_ERROR_CODE_UNKNOWN_GENERATOR ErrorCode = 10012
+	// INTERNAL, the random source failed or the monotonic ulid ran out of its millisecond
+	ErrorCode_ERROR_CODE_GENERATE_FAILED ErrorCode = 10013
+	// INVALID_ARGUMENT, the namespace is neither an alias nor a uuid
+	ErrorCode_ERROR_CODE_UNKNOWN_NAMESPACE ErrorCode = 10014
+	// INVALID_ARGUMENT, the version is not 3 or 5
+	ErrorCode_ERROR_CODE_INVALID_UUID_VERSION ErrorCode = 10015
+	// INVALID_ARGUMENT, the batch is empty or larger than maxBatchSize
+	ErrorCode_ERROR_CODE_INVALID_BATCH_COUNT ErrorCode = 10016
+	// INVALID_ARGUMENT, the format is not one of the snowflake encodings
+	ErrorCode_ERROR_CODE_UNKNOWN_FORMAT ErrorCode = 10017
+	// INVALID_ARGUMENT, the id could not have been generated with the layout of the generator
+	ErrorCode_ERROR_CODE_INVALID_SNOWFLAKE ErrorCode = 10018
+	// INVALID_ARGUMENT, the time window is reversed or outside of the layout
+	ErrorCode_ERROR_CODE_INVALID_TIME_WINDOW ErrorCode = 10019
+	// INVALID_ARGUMENT, the public id is not a prefix, an underscore and a base62 body
+	ErrorCode_ERROR_CODE_INVALID_PUBLIC_ID ErrorCode = 10020
+	// INVALID_ARGUMENT, the obfuscated id is not a key version and a base62 id
+	ErrorCode_ERROR_CODE_INVALID_OBFUSCATED_ID ErrorCode = 10021
+	// FAILED_PRECONDITION, there are no obfuscation keys
+	ErrorCode_ERROR_CODE_OBFUSCATION_DISABLED ErrorCode = 10022
+	// FAILED_PRECONDITION, enableSegment is off
+	ErrorCode_ERROR_CODE_SEGMENT_DISABLED ErrorCode = 10023
+	// NOT_FOUND, the biz tag has no row in leaf_alloc
+	ErrorCode_ERROR_CODE_UNKNOWN_BIZ_TAG ErrorCode = 10024
+	// UNAVAILABLE, the segment is used up and the database did not hand out the next one in time
+	ErrorCode_ERROR_CODE_SEGMENT_UNAVAILABLE ErrorCode = 10025
+	// NOT_FOUND, the sequence is not configured
+	ErrorCode_ERROR_CODE_UNKNOWN_SEQUENCE ErrorCode = 10026
+	// UNAVAILABLE, the range of the sequence is used up and redis did not reserve the next one
+	ErrorCode_ERROR_CODE_SEQUENCE_UNAVAILABLE ErrorCode = 10027
+	// FAILED_PRECONDITION, the request has an idempotency key but there is no idempotency store
+	ErrorCode_ERROR_CODE_IDEMPOTENCY_DISABLED ErrorCode = 10028
+	// INVALID_ARGUMENT, the idempotency key is too long
+	ErrorCode_ERROR_CODE_INVALID_IDEMPOTENCY_KEY ErrorCode = 10029
+	// RESOURCE_EXHAUSTED, the memory idempotency store is full of keys that have not expired yet
+	ErrorCode_ERROR_CODE_IDEMPOTENCY_STORE_FULL ErrorCode = 10030
+	// UNAVAILABLE, the idempotency store failed, no ids were handed out
+	ErrorCode_ERROR_CODE_IDEMPOTENCY_UNAVAILABLE ErrorCode = 10031
+	// FAILED_PRECONDITION, enableNodeLeases is off
+	ErrorCode_ERROR_CODE_NODE_LEASES_DISABLED ErrorCode = 10032
+	// INVALID_ARGUMENT, the owner of the lease is too long
+	ErrorCode_ERROR_CODE_INVALID_LEASE_OWNER ErrorCode = 10033
+	// NOT_FOUND, the lease expired, was released or never existed, its node id must not be used anymore
+	ErrorCode_ERROR_CODE_NODE_LEASE_LOST ErrorCode = 10034
+	// UNAVAILABLE, redis or etcd failed to lease, renew or list node ids
+	ErrorCode_ERROR_CODE_NODE_LEASE_UNAVAILABLE ErrorCode = 10035
+)
+
+// Enum value maps for ErrorCode.
+var (
+	ErrorCode_name = map[int32]string{
+		0:     "ERROR_CODE_UNSPECIFIED",
+		10001: "ERROR_CODE_UNKNOWN_PUBLIC_ID_PREFIX",
+		10002: "ERROR_CODE_PUBLIC_ID_CHECKSUM_MISMATCH",
+		10003: "ERROR_CODE_UNKNOWN_OBFUSCATION_KEY_VERSION",
+		10004: "ERROR_CODE_IDEMPOTENCY_KEY_REUSED",
+		10005: "ERROR_CODE_INVALID_REQUEST",
+		10006: "ERROR_CODE_INTERNAL",
+		10007: "ERROR_CODE_UNKNOWN_ROUTE",
+		10008: "ERROR_CODE_NODE_LEASE_EXPIRED",
+		10009: "ERROR_CODE_NODE_ID_EXHAUSTED",
+		10010: "ERROR_CODE_CLOCK_ROLLBACK",
+		10011: "ERROR_CODE_CLOCK_BEHIND_HIGH_WATER_MARK",
+		10012: "ERROR_CODE_UNKNOWN_GENERATOR",
+		10013: "ERROR_CODE_GENERATE_FAILED",
+		10014: "ERROR_CODE_UNKNOWN_NAMESPACE",
+		10015: "ERROR_CODE_INVALID_UUID_VERSION",
+		10016: "ERROR_CODE_INVALID_BATCH_COUNT",
+		10017: "ERROR_CODE_UNKNOWN_FORMAT",
+		10018: "ERROR_CODE_INVALID_SNOWFLAKE",
+		10019: "ERROR_CODE_INVALID_TIME_WINDOW",
+		10020: "ERROR_CODE_INVALID_PUBLIC_ID",
+		10021: "ERROR_CODE_INVALID_OBFUSCATED_ID",
+		10022: "ERROR_CODE_OBFUSCATION_DISABLED",
+		10023: "ERROR_CODE_SEGMENT_DISABLED",
+		10024: "ERROR_CODE_UNKNOWN_BIZ_TAG",
+		10025: "ERROR_CODE_SEGMENT_UNAVAILABLE",
+		10026: "ERROR_CODE_UNKNOWN_SEQUENCE",
+		10027: "ERROR_CODE_SEQUENCE_UNAVAILABLE",
+		10028: "ERROR_CODE_IDEMPOTENCY_DISABLED",
+		10029: "ERROR_CODE_INVALID_IDEMPOTENCY_KEY",
+		10030: "ERROR_CODE_IDEMPOTENCY_STORE_FULL",
+		10031: "ERROR_CODE_IDEMPOTENCY_UNAVAILABLE",
+		10032: "ERROR_CODE_NODE_LEASES_DISABLED",
+		10033: "ERROR_CODE_INVALID_LEASE_OWNER",
+		10034: "ERROR_CODE_NODE_LEASE_LOST",
+		10035: "ERROR_CODE_NODE_LEASE_UNAVAILABLE",
+	}
+	ErrorCode_value = map[string]int32{
+		"ERROR_CODE_UNSPECIFIED":                     0,
+		"ERROR_CODE_UNKNOWN_PUBLIC_ID_PREFIX":        10001,
+		"ERROR_CODE_PUBLIC_ID_CHECKSUM_MISMATCH":     10002,
+		"ERROR_CODE_UNKNOWN_OBFUSCATION_KEY_VERSION": 10003,
+		"ERROR_CODE_IDEMPOTENCY_KEY_REUSED":          10004,
+		"ERROR_CODE_INVALID_REQUEST":                 10005,
+		"ERROR_CODE_INTERNAL":                        10006,
+		"ERROR_CODE_UNKNOWN_ROUTE":                   10007,
+		"ERROR_CODE_NODE_LEASE_EXPIRED":              10008,
+		"ERROR_CODE_NODE_ID_EXHAUSTED":               10009,
+		"ERROR_CODE_CLOCK_ROLLBACK":                  10010,
+		"ERROR_CODE_CLOCK_BEHIND_HIGH_WATER_MARK":    10011,
+		"ERROR_CODE_UNKNOWN_GENERATOR":               10012,
+		"ERROR_CODE_GENERATE_FAILED":                 10013,
+		"ERROR_CODE_UNKNOWN_NAMESPACE":               10014,
+		"ERROR_CODE_INVALID_UUID_VERSION":            10015,
+		"ERROR_CODE_INVALID_BATCH_COUNT":             10016,
+		"ERROR_CODE_UNKNOWN_FORMAT":                  10017,
+		"ERROR_CODE_INVALID_SNOWFLAKE":               10018,
+		"ERROR_CODE_INVALID_TIME_WINDOW":             10019,
+		"ERROR_CODE_INVALID_PUBLIC_ID":               10020,
+		"ERROR_CODE_INVALID_OBFUSCATED_ID":           10021,
+		"ERROR_CODE_OBFUSCATION_DISABLED":            10022,
+		"ERROR_CODE_SEGMENT_DISABLED":                10023,
+		"ERROR_CODE_UNKNOWN_BIZ_TAG":                 10024,
+		"ERROR_CODE_SEGMENT_UNAVAILABLE":             10025,
+		"ERROR_CODE_UNKNOWN_SEQUENCE":                10026,
+		"ERROR_CODE_SEQUENCE_UNAVAILABLE":            10027,
+		"ERROR_CODE_IDEMPOTENCY_DISABLED":            10028,
+		"ERROR_CODE_INVALID_IDEMPOTENCY_KEY":         10029,
+		"ERROR_CODE_IDEMPOTENCY_STORE_FULL":          10030,
+		"ERROR_CODE_IDEMPOTENCY_UNAVAILABLE":         10031,
+		"ERROR_CODE_NODE_LEASES_DISABLED":            10032,
+		"ERROR_CODE_INVALID_LEASE_OWNER":             10033,
+		"ERROR_CODE_NODE_LEASE_LOST":                 10034,
+		"ERROR_CODE_NODE_LEASE_UNAVAILABLE":          10035,
+	}
+)
+
+func (x ErrorCode) Enum() *ErrorCode {
+	p := new(ErrorCode)
+	*p = x
+	return p
+}
+
+func (x ErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_uuid_v1_uuid_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorCode) Type() protoreflect.EnumType {
+	return &file_uuid_v1_uuid_proto_enumTypes[0]
+}
+
+func (x ErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorCode.Descriptor instead.
+func (ErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{0}
+}
+
 // The request message is contains the nodeId.
 type GetUuidBySnowflakeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// name of the generator, the one of [jupiter.server.uuid] if empty
+	Generator string `protobuf:"bytes,1,opt,name=generator,proto3" json:"generator,omitempty"`
+	// encoding of the uuids: int64 (decimal), hex, base32, base36, base58 or base62, int64 if empty
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	// key of the client request, retries with the same key get the same uuid back within idempotencyTTL
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
 func (x *GetUuidBySnowflakeRequest) Reset() {
@@ -59,6 +254,27 @@ func (*GetUuidBySnowflakeRequest) Descriptor() ([]byte, []int) {
 	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *GetUuidBySnowflakeRequest) GetGenerator() string {
+	if x != nil {
+		return x.Generator
+	}
+	return ""
+}
+
+func (x *GetUuidBySnowflakeRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *GetUuidBySnowflakeRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 // The response message containing the UUID.
 type GetUuidBySnowflakeResponse struct {
 	state         protoimpl.MessageState
@@ -126,15 +342,24 @@ func (x *GetUuidBySnowflakeResponse) GetData() *GetUuidBySnowflakeResponse_Data
 	return nil
 }
 
-// The request message is null.
-type GetUuidByGoogleUUIDV4Request struct {
+// The request message containing the number of uuids.
+type GetUuidBySnowflakeBatchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// count of uuids, at most maxBatchSize of the server
+	Count uint32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// name of the generator, the one of [jupiter.server.uuid] if empty
+	Generator string `protobuf:"bytes,2,opt,name=generator,proto3" json:"generator,omitempty"`
+	// encoding of the uuids: int64 (decimal), hex, base32, base36, base58 or base62, int64 if empty
+	Format string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	// key of the client request, retries with the same key get the same uuids back within idempotencyTTL
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
-func (x *GetUuidByGoogleUUIDV4Request) Reset() {
-	*x = GetUuidByGoogleUUIDV4Request{}
+func (x *GetUuidBySnowflakeBatchRequest) Reset() {
+	*x = GetUuidBySnowflakeBatchRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_uuid_v1_uuid_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -142,13 +367,13 @@ func (x *GetUuidByGoogleUUIDV4Request) Reset() {
 	}
 }
 
-func (x *GetUuidByGoogleUUIDV4Request) String() string {
+func (x *GetUuidBySnowflakeBatchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUuidByGoogleUUIDV4Request) ProtoMessage() {}
+func (*GetUuidBySnowflakeBatchRequest) ProtoMessage() {}
 
-func (x *GetUuidByGoogleUUIDV4Request) ProtoReflect() protoreflect.Message {
+func (x *GetUuidBySnowflakeBatchRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_uuid_v1_uuid_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -160,13 +385,41 @@ func (x *GetUuidByGoogleUUIDV4Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUuidByGoogleUUIDV4Request.ProtoReflect.Descriptor instead.
-func (*GetUuidByGoogleUUIDV4Request) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUuidBySnowflakeBatchRequest.ProtoReflect.Descriptor instead.
+func (*GetUuidBySnowflakeBatchRequest) Descriptor() ([]byte, []int) {
 	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{2}
 }
 
-// The response message containing the UUID.
-type GetUuidByGoogleUUIDV4Response struct {
+func (x *GetUuidBySnowflakeBatchRequest) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetUuidBySnowflakeBatchRequest) GetGenerator() string {
+	if x != nil {
+		return x.Generator
+	}
+	return ""
+}
+
+func (x *GetUuidBySnowflakeBatchRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *GetUuidBySnowflakeBatchRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+// The response message containing the UUIDs.
+type GetUuidBySnowflakeBatchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -176,11 +429,11 @@ type GetUuidByGoogleUUIDV4Response struct {
 	// msg
 	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
 	// data ...
-	Data *GetUuidByGoogleUUIDV4Response_Data `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Data *GetUuidBySnowflakeBatchResponse_Data `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *GetUuidByGoogleUUIDV4Response) Reset() {
-	*x = GetUuidByGoogleUUIDV4Response{}
+func (x *GetUuidBySnowflakeBatchResponse) Reset() {
+	*x = GetUuidBySnowflakeBatchResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_uuid_v1_uuid_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -188,13 +441,13 @@ func (x *GetUuidByGoogleUUIDV4Response) Reset() {
 	}
 }
 
-func (x *GetUuidByGoogleUUIDV4Response) String() string {
+func (x *GetUuidBySnowflakeBatchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUuidByGoogleUUIDV4Response) ProtoMessage() {}
+func (*GetUuidBySnowflakeBatchResponse) ProtoMessage() {}
 
-func (x *GetUuidByGoogleUUIDV4Response) ProtoReflect() protoreflect.Message {
+func (x *GetUuidBySnowflakeBatchResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_uuid_v1_uuid_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -206,44 +459,52 @@ func (x *GetUuidByGoogleUUIDV4Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUuidByGoogleUUIDV4Response.ProtoReflect.Descriptor instead.
-func (*GetUuidByGoogleUUIDV4Response) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUuidBySnowflakeBatchResponse.ProtoReflect.Descriptor instead.
+func (*GetUuidBySnowflakeBatchResponse) Descriptor() ([]byte, []int) {
 	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetUuidByGoogleUUIDV4Response) GetError() uint32 {
+func (x *GetUuidBySnowflakeBatchResponse) GetError() uint32 {
 	if x != nil {
 		return x.Error
 	}
 	return 0
 }
 
-func (x *GetUuidByGoogleUUIDV4Response) GetMsg() string {
+func (x *GetUuidBySnowflakeBatchResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-func (x *GetUuidByGoogleUUIDV4Response) GetData() *GetUuidByGoogleUUIDV4Response_Data {
+func (x *GetUuidBySnowflakeBatchResponse) GetData() *GetUuidBySnowflakeBatchResponse_Data {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-// Data ...
-type GetUuidBySnowflakeResponse_Data struct {
+// The request message containing the size of the chunks and the credit of the client.
+// The first request of the stream opens it, the later ones only grant credit, their other fields are ignored.
+type StreamUuidBySnowflakeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// message ...
-	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// uuids per chunk, at most maxBatchSize of the server, 100 if not set
+	ChunkSize uint32 `protobuf:"varint,1,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	// name of the generator, the one of [jupiter.server.uuid] if empty
+	Generator string `protobuf:"bytes,2,opt,name=generator,proto3" json:"generator,omitempty"`
+	// encoding of the uuids: int64 (decimal), hex, base32, base36, base58 or base62, int64 if empty
+	Format string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	// chunks the client is ready to take on top of the credit it granted before,
+	// the server generates nothing while the client has no credit left
+	Credit uint32 `protobuf:"varint,4,opt,name=credit,proto3" json:"credit,omitempty"`
 }
 
-func (x *GetUuidBySnowflakeResponse_Data) Reset() {
-	*x = GetUuidBySnowflakeResponse_Data{}
+func (x *StreamUuidBySnowflakeRequest) Reset() {
+	*x = StreamUuidBySnowflakeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_uuid_v1_uuid_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -251,13 +512,13 @@ func (x *GetUuidBySnowflakeResponse_Data) Reset() {
 	}
 }
 
-func (x *GetUuidBySnowflakeResponse_Data) String() string {
+func (x *StreamUuidBySnowflakeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUuidBySnowflakeResponse_Data) ProtoMessage() {}
+func (*StreamUuidBySnowflakeRequest) ProtoMessage() {}
 
-func (x *GetUuidBySnowflakeResponse_Data) ProtoReflect() protoreflect.Message {
+func (x *StreamUuidBySnowflakeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_uuid_v1_uuid_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -269,30 +530,55 @@ func (x *GetUuidBySnowflakeResponse_Data) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUuidBySnowflakeResponse_Data.ProtoReflect.Descriptor instead.
-func (*GetUuidBySnowflakeResponse_Data) Descriptor() ([]byte, []int) {
-	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use StreamUuidBySnowflakeRequest.ProtoReflect.Descriptor instead.
+func (*StreamUuidBySnowflakeRequest) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetUuidBySnowflakeResponse_Data) GetUuid() string {
+func (x *StreamUuidBySnowflakeRequest) GetChunkSize() uint32 {
 	if x != nil {
-		return x.Uuid
+		return x.ChunkSize
+	}
+	return 0
+}
+
+func (x *StreamUuidBySnowflakeRequest) GetGenerator() string {
+	if x != nil {
+		return x.Generator
 	}
 	return ""
 }
 
-// Data ...
-type GetUuidByGoogleUUIDV4Response_Data struct {
+func (x *StreamUuidBySnowflakeRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *StreamUuidBySnowflakeRequest) GetCredit() uint32 {
+	if x != nil {
+		return x.Credit
+	}
+	return 0
+}
+
+// The response message containing one chunk of UUIDs.
+type StreamUuidBySnowflakeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// message ...
-	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// error, the stream ends after a chunk with an error
+	Error uint32 `protobuf:"varint,1,opt,name=error,proto3" json:"error,omitempty"`
+	// msg
+	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// data ...
+	Data *StreamUuidBySnowflakeResponse_Data `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *GetUuidByGoogleUUIDV4Response_Data) Reset() {
-	*x = GetUuidByGoogleUUIDV4Response_Data{}
+func (x *StreamUuidBySnowflakeResponse) Reset() {
+	*x = StreamUuidBySnowflakeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_uuid_v1_uuid_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -300,13 +586,13 @@ func (x *GetUuidByGoogleUUIDV4Response_Data) Reset() {
 	}
 }
 
-func (x *GetUuidByGoogleUUIDV4Response_Data) String() string {
+func (x *StreamUuidBySnowflakeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUuidByGoogleUUIDV4Response_Data) ProtoMessage() {}
+func (*StreamUuidBySnowflakeResponse) ProtoMessage() {}
 
-func (x *GetUuidByGoogleUUIDV4Response_Data) ProtoReflect() protoreflect.Message {
+func (x *StreamUuidBySnowflakeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_uuid_v1_uuid_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -318,140 +604,4960 @@ func (x *GetUuidByGoogleUUIDV4Response_Data) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUuidByGoogleUUIDV4Response_Data.ProtoReflect.Descriptor instead.
-func (*GetUuidByGoogleUUIDV4Response_Data) Descriptor() ([]byte, []int) {
-	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{3, 0}
+// Deprecated: Use StreamUuidBySnowflakeResponse.ProtoReflect.Descriptor instead.
+func (*StreamUuidBySnowflakeResponse) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetUuidByGoogleUUIDV4Response_Data) GetUuid() string {
+func (x *StreamUuidBySnowflakeResponse) GetError() uint32 {
 	if x != nil {
-		return x.Uuid
+		return x.Error
+	}
+	return 0
+}
+
+func (x *StreamUuidBySnowflakeResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
 	}
 	return ""
 }
 
-var File_uuid_v1_uuid_proto protoreflect.FileDescriptor
+func (x *StreamUuidBySnowflakeResponse) GetData() *StreamUuidBySnowflakeResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
 
-var file_uuid_v1_uuid_proto_rawDesc = []byte{
-	0x0a, 0x12, 0x75, 0x75, 0x69, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x22, 0x1b, 0x0a,
-	0x19, 0x47, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79, 0x53, 0x6e, 0x6f, 0x77, 0x66, 0x6c,
-	0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9e, 0x01, 0x0a, 0x1a, 0x47,
-	0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79, 0x53, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
-	0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73,
-	0x67, 0x12, 0x3c, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x28, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x75, 0x69,
-	0x64, 0x42, 0x79, 0x53, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a,
-	0x1a, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x1e, 0x0a, 0x1c, 0x47,
-	0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x55, 0x55,
-	0x49, 0x44, 0x56, 0x34, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa4, 0x01, 0x0a, 0x1d,
-	0x47, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x55,
-	0x55, 0x49, 0x44, 0x56, 0x34, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x3f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x55, 0x55, 0x49,
-	0x44, 0x56, 0x34, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x1a, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12,
-	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x32, 0xd8, 0x01, 0x0a, 0x0b, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x5f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79, 0x53,
-	0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x12, 0x22, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79, 0x53, 0x6e, 0x6f, 0x77,
-	0x66, 0x6c, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x75,
-	0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79,
-	0x53, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79,
-	0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x55, 0x55, 0x49, 0x44, 0x56, 0x34, 0x12, 0x25, 0x2e, 0x75,
-	0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79,
-	0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x55, 0x55, 0x49, 0x44, 0x56, 0x34, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x55, 0x55, 0x49,
-	0x44, 0x56, 0x34, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x65, 0x0a,
-	0x0b, 0x63, 0x6f, 0x6d, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x42, 0x09, 0x55, 0x75,
-	0x69, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x0e, 0x75, 0x75, 0x69, 0x64, 0x2f,
-	0x76, 0x31, 0x3b, 0x75, 0x75, 0x69, 0x64, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x55, 0x58, 0x58, 0xaa,
-	0x02, 0x07, 0x55, 0x75, 0x69, 0x64, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x07, 0x55, 0x75, 0x69, 0x64,
-	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x13, 0x55, 0x75, 0x69, 0x64, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x08, 0x55, 0x75, 0x69, 0x64,
-	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+// The request message containing the snowflake uuid.
+type ParseSnowflakeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uuid in format
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// name of the generator, the one of [jupiter.server.uuid] if empty
+	Generator string `protobuf:"bytes,2,opt,name=generator,proto3" json:"generator,omitempty"`
+	// encoding of the uuid: int64 (decimal), hex, base32, base36, base58 or base62, int64 if empty
+	Format string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
 }
 
-var (
-	file_uuid_v1_uuid_proto_rawDescOnce sync.Once
-	file_uuid_v1_uuid_proto_rawDescData = file_uuid_v1_uuid_proto_rawDesc
-)
+func (x *ParseSnowflakeRequest) Reset() {
+	*x = ParseSnowflakeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_uuid_v1_uuid_proto_rawDescGZIP() []byte {
-	file_uuid_v1_uuid_proto_rawDescOnce.Do(func() {
-		file_uuid_v1_uuid_proto_rawDescData = protoimpl.X.CompressGZIP(file_uuid_v1_uuid_proto_rawDescData)
-	})
-	return file_uuid_v1_uuid_proto_rawDescData
+func (x *ParseSnowflakeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_uuid_v1_uuid_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
-var file_uuid_v1_uuid_proto_goTypes = []interface{}{
-	(*GetUuidBySnowflakeRequest)(nil),          // 0: uuid.v1.GetUuidBySnowflakeRequest
-	(*GetUuidBySnowflakeResponse)(nil),         // 1: uuid.v1.GetUuidBySnowflakeResponse
-	(*GetUuidByGoogleUUIDV4Request)(nil),       // 2: uuid.v1.GetUuidByGoogleUUIDV4Request
-	(*GetUuidByGoogleUUIDV4Response)(nil),      // 3: uuid.v1.GetUuidByGoogleUUIDV4Response
-	(*GetUuidBySnowflakeResponse_Data)(nil),    // 4: uuid.v1.GetUuidBySnowflakeResponse.Data
-	(*GetUuidByGoogleUUIDV4Response_Data)(nil), // 5: uuid.v1.GetUuidByGoogleUUIDV4Response.Data
+func (*ParseSnowflakeRequest) ProtoMessage() {}
+
+func (x *ParseSnowflakeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_uuid_v1_uuid_proto_depIdxs = []int32{
-	4, // 0: uuid.v1.GetUuidBySnowflakeResponse.data:type_name -> uuid.v1.GetUuidBySnowflakeResponse.Data
-	5, // 1: uuid.v1.GetUuidByGoogleUUIDV4Response.data:type_name -> uuid.v1.GetUuidByGoogleUUIDV4Response.Data
-	0, // 2: uuid.v1.UuidService.GetUuidBySnowflake:input_type -> uuid.v1.GetUuidBySnowflakeRequest
-	2, // 3: uuid.v1.UuidService.GetUuidByGoogleUUIDV4:input_type -> uuid.v1.GetUuidByGoogleUUIDV4Request
-	1, // 4: uuid.v1.UuidService.GetUuidBySnowflake:output_type -> uuid.v1.GetUuidBySnowflakeResponse
-	3, // 5: uuid.v1.UuidService.GetUuidByGoogleUUIDV4:output_type -> uuid.v1.GetUuidByGoogleUUIDV4Response
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+
+// Deprecated: Use ParseSnowflakeRequest.ProtoReflect.Descriptor instead.
+func (*ParseSnowflakeRequest) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{6}
 }
 
-func init() { file_uuid_v1_uuid_proto_init() }
-func file_uuid_v1_uuid_proto_init() {
-	if File_uuid_v1_uuid_proto != nil {
-		return
+func (x *ParseSnowflakeRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_uuid_v1_uuid_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUuidBySnowflakeRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_uuid_v1_uuid_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUuidBySnowflakeResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return ""
+}
+
+func (x *ParseSnowflakeRequest) GetGenerator() string {
+	if x != nil {
+		return x.Generator
+	}
+	return ""
+}
+
+func (x *ParseSnowflakeRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// The response message containing the parts of the UUID.
+type ParseSnowflakeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// error
+	Error uint32 `protobuf:"varint,1,opt,name=error,proto3" json:"error,omitempty"`
+	// msg
+	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// data ...
+	Data *ParseSnowflakeResponse_Data `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ParseSnowflakeResponse) Reset() {
+	*x = ParseSnowflakeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParseSnowflakeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseSnowflakeResponse) ProtoMessage() {}
+
+func (x *ParseSnowflakeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_uuid_v1_uuid_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUuidByGoogleUUIDV4Request); i {
-			case 0:
-				return &v.state
-			case 1:
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseSnowflakeResponse.ProtoReflect.Descriptor instead.
+func (*ParseSnowflakeResponse) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ParseSnowflakeResponse) GetError() uint32 {
+	if x != nil {
+		return x.Error
+	}
+	return 0
+}
+
+func (x *ParseSnowflakeResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ParseSnowflakeResponse) GetData() *ParseSnowflakeResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The request message containing the time window.
+type GetSnowflakeRangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// unix milliseconds the window starts at, inclusive, the start query param of http as before the gateway
+	StartTime int64 `protobuf:"varint,1,opt,name=start_time,json=start,proto3" json:"start_time,omitempty"`
+	// unix milliseconds the window ends at, inclusive, the end query param of http as before the gateway
+	EndTime int64 `protobuf:"varint,2,opt,name=end_time,json=end,proto3" json:"end_time,omitempty"`
+	// name of the generator, the one of [jupiter.server.uuid] if empty
+	Generator string `protobuf:"bytes,3,opt,name=generator,proto3" json:"generator,omitempty"`
+}
+
+func (x *GetSnowflakeRangeRequest) Reset() {
+	*x = GetSnowflakeRangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSnowflakeRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSnowflakeRangeRequest) ProtoMessage() {}
+
+func (x *GetSnowflakeRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSnowflakeRangeRequest.ProtoReflect.Descriptor instead.
+func (*GetSnowflakeRangeRequest) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetSnowflakeRangeRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *GetSnowflakeRangeRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *GetSnowflakeRangeRequest) GetGenerator() string {
+	if x != nil {
+		return x.Generator
+	}
+	return ""
+}
+
+// The response message containing the bounds of the UUIDs.
+type GetSnowflakeRangeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// error
+	Error uint32 `protobuf:"varint,1,opt,name=error,proto3" json:"error,omitempty"`
+	// msg
+	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// data ...
+	Data *GetSnowflakeRangeResponse_Data `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetSnowflakeRangeResponse) Reset() {
+	*x = GetSnowflakeRangeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSnowflakeRangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSnowflakeRangeResponse) ProtoMessage() {}
+
+func (x *GetSnowflakeRangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSnowflakeRangeResponse.ProtoReflect.Descriptor instead.
+func (*GetSnowflakeRangeResponse) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetSnowflakeRangeResponse) GetError() uint32 {
+	if x != nil {
+		return x.Error
+	}
+	return 0
+}
+
+func (x *GetSnowflakeRangeResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetSnowflakeRangeResponse) GetData() *GetSnowflakeRangeResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The request message is null.
+type GetUuidByGoogleUUIDV4Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetUuidByGoogleUUIDV4Request) Reset() {
+	*x = GetUuidByGoogleUUIDV4Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUuidByGoogleUUIDV4Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUuidByGoogleUUIDV4Request) ProtoMessage() {}
+
+func (x *GetUuidByGoogleUUIDV4Request) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUuidByGoogleUUIDV4Request.ProtoReflect.Descriptor instead.
+func (*GetUuidByGoogleUUIDV4Request) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{10}
+}
+
+// The response message containing the UUID.
+type GetUuidByGoogleUUIDV4Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// error
+	Error uint32 `protobuf:"varint,1,opt,name=error,proto3" json:"error,omitempty"`
+	// msg
+	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// data ...
+	Data *GetUuidByGoogleUUIDV4Response_Data `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetUuidByGoogleUUIDV4Response) Reset() {
+	*x = GetUuidByGoogleUUIDV4Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUuidByGoogleUUIDV4Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUuidByGoogleUUIDV4Response) ProtoMessage() {}
+
+func (x *GetUuidByGoogleUUIDV4Response) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUuidByGoogleUUIDV4Response.ProtoReflect.Descriptor instead.
+func (*GetUuidByGoogleUUIDV4Response) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetUuidByGoogleUUIDV4Response) GetError() uint32 {
+	if x != nil {
+		return x.Error
+	}
+	return 0
+}
+
+func (x *GetUuidByGoogleUUIDV4Response) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetUuidByGoogleUUIDV4Response) GetData() *GetUuidByGoogleUUIDV4Response_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The request message is null.
+type GetUuidV7Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetUuidV7Request) Reset() {
+	*x = GetUuidV7Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUuidV7Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUuidV7Request) ProtoMessage() {}
+
+func (x *GetUuidV7Request) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUuidV7Request.ProtoReflect.Descriptor instead.
+func (*GetUuidV7Request) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{12}
+}
+
+// The response message containing the UUID.
+type GetUuidV7Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// error
+	Error uint32 `protobuf:"varint,1,opt,name=error,proto3" json:"error,omitempty"`
+	// msg
+	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// data ...
+	Data *GetUuidV7Response_Data `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetUuidV7Response) Reset() {
+	*x = GetUuidV7Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUuidV7Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUuidV7Response) ProtoMessage() {}
+
+func (x *GetUuidV7Response) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUuidV7Response.ProtoReflect.Descriptor instead.
+func (*GetUuidV7Response) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetUuidV7Response) GetError() uint32 {
+	if x != nil {
+		return x.Error
+	}
+	return 0
+}
+
+func (x *GetUuidV7Response) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetUuidV7Response) GetData() *GetUuidV7Response_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The request message is null.
+type GetUlidRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetUlidRequest) Reset() {
+	*x = GetUlidRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUlidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUlidRequest) ProtoMessage() {}
+
+func (x *GetUlidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUlidRequest.ProtoReflect.Descriptor instead.
+func (*GetUlidRequest) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{14}
+}
+
+// The response message containing the ULID.
+type GetUlidResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// error
+	Error uint32 `protobuf:"varint,1,opt,name=error,proto3" json:"error,omitempty"`
+	// msg
+	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// data ...
+	Data *GetUlidResponse_Data `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetUlidResponse) Reset() {
+	*x = GetUlidResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUlidResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUlidResponse) ProtoMessage() {}
+
+func (x *GetUlidResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUlidResponse.ProtoReflect.Descriptor instead.
+func (*GetUlidResponse) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetUlidResponse) GetError() uint32 {
+	if x != nil {
+		return x.Error
+	}
+	return 0
+}
+
+func (x *GetUlidResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetUlidResponse) GetData() *GetUlidResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The request message is null.
+type GetKsuidRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetKsuidRequest) Reset() {
+	*x = GetKsuidRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetKsuidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKsuidRequest) ProtoMessage() {}
+
+func (x *GetKsuidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKsuidRequest.ProtoReflect.Descriptor instead.
+func (*GetKsuidRequest) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{16}
+}
+
+// The response message containing the KSUID.
+type GetKsuidResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// error
+	Error uint32 `protobuf:"varint,1,opt,name=error,proto3" json:"error,omitempty"`
+	// msg
+	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// data ...
+	Data *GetKsuidResponse_Data `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetKsuidResponse) Reset() {
+	*x = GetKsuidResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetKsuidResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKsuidResponse) ProtoMessage() {}
+
+func (x *GetKsuidResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKsuidResponse.ProtoReflect.Descriptor instead.
+func (*GetKsuidResponse) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetKsuidResponse) GetError() uint32 {
+	if x != nil {
+		return x.Error
+	}
+	return 0
+}
+
+func (x *GetKsuidResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetKsuidResponse) GetData() *GetKsuidResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The request message containing the namespace and the name.
+type GetUuidByNameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 3 for md5 or 5 for sha1, 5 if not set
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// alias of namespaces of the server, or a uuid
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// name
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetUuidByNameRequest) Reset() {
+	*x = GetUuidByNameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUuidByNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUuidByNameRequest) ProtoMessage() {}
+
+func (x *GetUuidByNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUuidByNameRequest.ProtoReflect.Descriptor instead.
+func (*GetUuidByNameRequest) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetUuidByNameRequest) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetUuidByNameRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetUuidByNameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// The response message containing the UUID.
+type GetUuidByNameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// error
+	Error uint32 `protobuf:"varint,1,opt,name=error,proto3" json:"error,omitempty"`
+	// msg
+	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// data ...
+	Data *GetUuidByNameResponse_Data `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetUuidByNameResponse) Reset() {
+	*x = GetUuidByNameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUuidByNameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUuidByNameResponse) ProtoMessage() {}
+
+func (x *GetUuidByNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUuidByNameResponse.ProtoReflect.Descriptor instead.
+func (*GetUuidByNameResponse) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetUuidByNameResponse) GetError() uint32 {
+	if x != nil {
+		return x.Error
+	}
+	return 0
+}
+
+func (x *GetUuidByNameResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetUuidByNameResponse) GetData() *GetUuidByNameResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The request message containing the namespace and the names.
+type GetUuidByNameBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 3 for md5 or 5 for sha1, 5 if not set
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// alias of namespaces of the server, or a uuid
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// names, at most maxBatchSize of the server
+	Names []string `protobuf:"bytes,3,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (x *GetUuidByNameBatchRequest) Reset() {
+	*x = GetUuidByNameBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUuidByNameBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUuidByNameBatchRequest) ProtoMessage() {}
+
+func (x *GetUuidByNameBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUuidByNameBatchRequest.ProtoReflect.Descriptor instead.
+func (*GetUuidByNameBatchRequest) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetUuidByNameBatchRequest) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetUuidByNameBatchRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetUuidByNameBatchRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+// The response message containing the UUIDs.
+type GetUuidByNameBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// error
+	Error uint32 `protobuf:"varint,1,opt,name=error,proto3" json:"error,omitempty"`
+	// msg
+	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// data ...
+	Data *GetUuidByNameBatchResponse_Data `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetUuidByNameBatchResponse) Reset() {
+	*x = GetUuidByNameBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUuidByNameBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUuidByNameBatchResponse) ProtoMessage() {}
+
+func (x *GetUuidByNameBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUuidByNameBatchResponse.ProtoReflect.Descriptor instead.
+func (*GetUuidByNameBatchResponse) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetUuidByNameBatchResponse) GetError() uint32 {
+	if x != nil {
+		return x.Error
+	}
+	return 0
+}
+
+func (x *GetUuidByNameBatchResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetUuidByNameBatchResponse) GetData() *GetUuidByNameBatchResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The request message containing the prefix.
+type GetPublicIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// prefix registered in prefixes of the server, e.g. ord
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+}
+
+func (x *GetPublicIdRequest) Reset() {
+	*x = GetPublicIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPublicIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicIdRequest) ProtoMessage() {}
+
+func (x *GetPublicIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicIdRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicIdRequest) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetPublicIdRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+// The response message containing the public id.
+type GetPublicIdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// error
+	Error uint32 `protobuf:"varint,1,opt,name=error,proto3" json:"error,omitempty"`
+	// msg
+	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// data ...
+	Data *GetPublicIdResponse_Data `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetPublicIdResponse) Reset() {
+	*x = GetPublicIdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPublicIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicIdResponse) ProtoMessage() {}
+
+func (x *GetPublicIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicIdResponse.ProtoReflect.Descriptor instead.
+func (*GetPublicIdResponse) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetPublicIdResponse) GetError() uint32 {
+	if x != nil {
+		return x.Error
+	}
+	return 0
+}
+
+func (x *GetPublicIdResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetPublicIdResponse) GetData() *GetPublicIdResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The request message containing the public id.
+type ValidatePublicIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// public id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ValidatePublicIdRequest) Reset() {
+	*x = ValidatePublicIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatePublicIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatePublicIdRequest) ProtoMessage() {}
+
+func (x *ValidatePublicIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatePublicIdRequest.ProtoReflect.Descriptor instead.
+func (*ValidatePublicIdRequest) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ValidatePublicIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// The response message containing whether the public id is valid.
+type ValidatePublicIdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// error
+	Error uint32 `protobuf:"varint,1,opt,name=error,proto3" json:"error,omitempty"`
+	// msg
+	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// data ...
+	Data *ValidatePublicIdResponse_Data `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ValidatePublicIdResponse) Reset() {
+	*x = ValidatePublicIdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatePublicIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatePublicIdResponse) ProtoMessage() {}
+
+func (x *ValidatePublicIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatePublicIdResponse.ProtoReflect.Descriptor instead.
+func (*ValidatePublicIdResponse) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ValidatePublicIdResponse) GetError() uint32 {
+	if x != nil {
+		return x.Error
+	}
+	return 0
+}
+
+func (x *ValidatePublicIdResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ValidatePublicIdResponse) GetData() *ValidatePublicIdResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The request message containing the public id.
+type ParsePublicIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// public id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ParsePublicIdRequest) Reset() {
+	*x = ParsePublicIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParsePublicIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParsePublicIdRequest) ProtoMessage() {}
+
+func (x *ParsePublicIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParsePublicIdRequest.ProtoReflect.Descriptor instead.
+func (*ParsePublicIdRequest) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ParsePublicIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// The response message containing the uuid of the public id.
+type ParsePublicIdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// error
+	Error uint32 `protobuf:"varint,1,opt,name=error,proto3" json:"error,omitempty"`
+	// msg
+	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// data ...
+	Data *ParsePublicIdResponse_Data `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ParsePublicIdResponse) Reset() {
+	*x = ParsePublicIdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParsePublicIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParsePublicIdResponse) ProtoMessage() {}
+
+func (x *ParsePublicIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParsePublicIdResponse.ProtoReflect.Descriptor instead.
+func (*ParsePublicIdResponse) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ParsePublicIdResponse) GetError() uint32 {
+	if x != nil {
+		return x.Error
+	}
+	return 0
+}
+
+func (x *ParsePublicIdResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ParsePublicIdResponse) GetData() *ParsePublicIdResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The request message containing the snowflake uuid.
+type EncodeObfuscatedIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// snowflake uuid as a number, at most 63 bits
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *EncodeObfuscatedIdRequest) Reset() {
+	*x = EncodeObfuscatedIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EncodeObfuscatedIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncodeObfuscatedIdRequest) ProtoMessage() {}
+
+func (x *EncodeObfuscatedIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncodeObfuscatedIdRequest.ProtoReflect.Descriptor instead.
+func (*EncodeObfuscatedIdRequest) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *EncodeObfuscatedIdRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// The response message containing the obfuscated id.
+type EncodeObfuscatedIdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// error
+	Error uint32 `protobuf:"varint,1,opt,name=error,proto3" json:"error,omitempty"`
+	// msg
+	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// data ...
+	Data *EncodeObfuscatedIdResponse_Data `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *EncodeObfuscatedIdResponse) Reset() {
+	*x = EncodeObfuscatedIdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EncodeObfuscatedIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncodeObfuscatedIdResponse) ProtoMessage() {}
+
+func (x *EncodeObfuscatedIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncodeObfuscatedIdResponse.ProtoReflect.Descriptor instead.
+func (*EncodeObfuscatedIdResponse) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *EncodeObfuscatedIdResponse) GetError() uint32 {
+	if x != nil {
+		return x.Error
+	}
+	return 0
+}
+
+func (x *EncodeObfuscatedIdResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *EncodeObfuscatedIdResponse) GetData() *EncodeObfuscatedIdResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The request message containing the obfuscated id.
+type DecodeObfuscatedIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// obfuscated id, the id query param of http as before the gateway
+	ObfuscatedId string `protobuf:"bytes,1,opt,name=obfuscated_id,json=id,proto3" json:"obfuscated_id,omitempty"`
+}
+
+func (x *DecodeObfuscatedIdRequest) Reset() {
+	*x = DecodeObfuscatedIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodeObfuscatedIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeObfuscatedIdRequest) ProtoMessage() {}
+
+func (x *DecodeObfuscatedIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeObfuscatedIdRequest.ProtoReflect.Descriptor instead.
+func (*DecodeObfuscatedIdRequest) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DecodeObfuscatedIdRequest) GetObfuscatedId() string {
+	if x != nil {
+		return x.ObfuscatedId
+	}
+	return ""
+}
+
+// The response message containing the snowflake uuid.
+type DecodeObfuscatedIdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// error
+	Error uint32 `protobuf:"varint,1,opt,name=error,proto3" json:"error,omitempty"`
+	// msg
+	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// data ...
+	Data *DecodeObfuscatedIdResponse_Data `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *DecodeObfuscatedIdResponse) Reset() {
+	*x = DecodeObfuscatedIdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodeObfuscatedIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeObfuscatedIdResponse) ProtoMessage() {}
+
+func (x *DecodeObfuscatedIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeObfuscatedIdResponse.ProtoReflect.Descriptor instead.
+func (*DecodeObfuscatedIdResponse) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DecodeObfuscatedIdResponse) GetError() uint32 {
+	if x != nil {
+		return x.Error
+	}
+	return 0
+}
+
+func (x *DecodeObfuscatedIdResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *DecodeObfuscatedIdResponse) GetData() *DecodeObfuscatedIdResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The request message containing the biz tag.
+type GetSegmentIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// biz tag of a row in leaf_alloc
+	BizTag string `protobuf:"bytes,1,opt,name=biz_tag,json=bizTag,proto3" json:"biz_tag,omitempty"`
+}
+
+func (x *GetSegmentIdRequest) Reset() {
+	*x = GetSegmentIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSegmentIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSegmentIdRequest) ProtoMessage() {}
+
+func (x *GetSegmentIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSegmentIdRequest.ProtoReflect.Descriptor instead.
+func (*GetSegmentIdRequest) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetSegmentIdRequest) GetBizTag() string {
+	if x != nil {
+		return x.BizTag
+	}
+	return ""
+}
+
+// The response message containing the id.
+type GetSegmentIdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// error
+	Error uint32 `protobuf:"varint,1,opt,name=error,proto3" json:"error,omitempty"`
+	// msg
+	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// data ...
+	Data *GetSegmentIdResponse_Data `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetSegmentIdResponse) Reset() {
+	*x = GetSegmentIdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSegmentIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSegmentIdResponse) ProtoMessage() {}
+
+func (x *GetSegmentIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSegmentIdResponse.ProtoReflect.Descriptor instead.
+func (*GetSegmentIdResponse) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetSegmentIdResponse) GetError() uint32 {
+	if x != nil {
+		return x.Error
+	}
+	return 0
+}
+
+func (x *GetSegmentIdResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetSegmentIdResponse) GetData() *GetSegmentIdResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The request message containing the sequence key.
+type GetSequenceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// key of a sequence in sequences of [jupiter.server.uuid]
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *GetSequenceRequest) Reset() {
+	*x = GetSequenceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSequenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSequenceRequest) ProtoMessage() {}
+
+func (x *GetSequenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSequenceRequest.ProtoReflect.Descriptor instead.
+func (*GetSequenceRequest) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetSequenceRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// The response message containing the number.
+type GetSequenceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// error
+	Error uint32 `protobuf:"varint,1,opt,name=error,proto3" json:"error,omitempty"`
+	// msg
+	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// data ...
+	Data *GetSequenceResponse_Data `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetSequenceResponse) Reset() {
+	*x = GetSequenceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSequenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSequenceResponse) ProtoMessage() {}
+
+func (x *GetSequenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSequenceResponse.ProtoReflect.Descriptor instead.
+func (*GetSequenceResponse) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetSequenceResponse) GetError() uint32 {
+	if x != nil {
+		return x.Error
+	}
+	return 0
+}
+
+func (x *GetSequenceResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetSequenceResponse) GetData() *GetSequenceResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The request message containing the owner of the lease.
+type LeaseNodeIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name of the client, it shows up in the owner of the lease
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// name of the generator whose layout the client generates with, the one of [jupiter.server.uuid] if empty
+	Generator string `protobuf:"bytes,2,opt,name=generator,proto3" json:"generator,omitempty"`
+}
+
+func (x *LeaseNodeIdRequest) Reset() {
+	*x = LeaseNodeIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseNodeIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseNodeIdRequest) ProtoMessage() {}
+
+func (x *LeaseNodeIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseNodeIdRequest.ProtoReflect.Descriptor instead.
+func (*LeaseNodeIdRequest) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *LeaseNodeIdRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *LeaseNodeIdRequest) GetGenerator() string {
+	if x != nil {
+		return x.Generator
+	}
+	return ""
+}
+
+// The response message containing the leased node id and the layout to generate with.
+type LeaseNodeIdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// error
+	Error uint32 `protobuf:"varint,1,opt,name=error,proto3" json:"error,omitempty"`
+	// msg
+	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// data ...
+	Data *LeaseNodeIdResponse_Data `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *LeaseNodeIdResponse) Reset() {
+	*x = LeaseNodeIdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseNodeIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseNodeIdResponse) ProtoMessage() {}
+
+func (x *LeaseNodeIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseNodeIdResponse.ProtoReflect.Descriptor instead.
+func (*LeaseNodeIdResponse) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *LeaseNodeIdResponse) GetError() uint32 {
+	if x != nil {
+		return x.Error
+	}
+	return 0
+}
+
+func (x *LeaseNodeIdResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *LeaseNodeIdResponse) GetData() *LeaseNodeIdResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The request message containing the lease.
+type RenewNodeLeaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// node id leased to the client
+	NodeId int64 `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// lease of LeaseNodeId
+	Lease string `protobuf:"bytes,2,opt,name=lease,proto3" json:"lease,omitempty"`
+}
+
+func (x *RenewNodeLeaseRequest) Reset() {
+	*x = RenewNodeLeaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenewNodeLeaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewNodeLeaseRequest) ProtoMessage() {}
+
+func (x *RenewNodeLeaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewNodeLeaseRequest.ProtoReflect.Descriptor instead.
+func (*RenewNodeLeaseRequest) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RenewNodeLeaseRequest) GetNodeId() int64 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *RenewNodeLeaseRequest) GetLease() string {
+	if x != nil {
+		return x.Lease
+	}
+	return ""
+}
+
+// The response message containing the ttl of the lease.
+type RenewNodeLeaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// error
+	Error uint32 `protobuf:"varint,1,opt,name=error,proto3" json:"error,omitempty"`
+	// msg
+	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// data ...
+	Data *RenewNodeLeaseResponse_Data `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *RenewNodeLeaseResponse) Reset() {
+	*x = RenewNodeLeaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenewNodeLeaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewNodeLeaseResponse) ProtoMessage() {}
+
+func (x *RenewNodeLeaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewNodeLeaseResponse.ProtoReflect.Descriptor instead.
+func (*RenewNodeLeaseResponse) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RenewNodeLeaseResponse) GetError() uint32 {
+	if x != nil {
+		return x.Error
+	}
+	return 0
+}
+
+func (x *RenewNodeLeaseResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *RenewNodeLeaseResponse) GetData() *RenewNodeLeaseResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The request message containing the lease.
+type ReleaseNodeLeaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// node id leased to the client
+	NodeId int64 `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// lease of LeaseNodeId
+	Lease string `protobuf:"bytes,2,opt,name=lease,proto3" json:"lease,omitempty"`
+	// unix milliseconds of the last id the client generated, the high-water mark of the node id is kept at least at it
+	Last int64 `protobuf:"varint,3,opt,name=last,proto3" json:"last,omitempty"`
+}
+
+func (x *ReleaseNodeLeaseRequest) Reset() {
+	*x = ReleaseNodeLeaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseNodeLeaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseNodeLeaseRequest) ProtoMessage() {}
+
+func (x *ReleaseNodeLeaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseNodeLeaseRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseNodeLeaseRequest) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ReleaseNodeLeaseRequest) GetNodeId() int64 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *ReleaseNodeLeaseRequest) GetLease() string {
+	if x != nil {
+		return x.Lease
+	}
+	return ""
+}
+
+func (x *ReleaseNodeLeaseRequest) GetLast() int64 {
+	if x != nil {
+		return x.Last
+	}
+	return 0
+}
+
+// The response message of the release.
+type ReleaseNodeLeaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// error
+	Error uint32 `protobuf:"varint,1,opt,name=error,proto3" json:"error,omitempty"`
+	// msg
+	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *ReleaseNodeLeaseResponse) Reset() {
+	*x = ReleaseNodeLeaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseNodeLeaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseNodeLeaseResponse) ProtoMessage() {}
+
+func (x *ReleaseNodeLeaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseNodeLeaseResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseNodeLeaseResponse) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ReleaseNodeLeaseResponse) GetError() uint32 {
+	if x != nil {
+		return x.Error
+	}
+	return 0
+}
+
+func (x *ReleaseNodeLeaseResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+// The request message is null.
+type ListNodeLeasesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListNodeLeasesRequest) Reset() {
+	*x = ListNodeLeasesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListNodeLeasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNodeLeasesRequest) ProtoMessage() {}
+
+func (x *ListNodeLeasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNodeLeasesRequest.ProtoReflect.Descriptor instead.
+func (*ListNodeLeasesRequest) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{42}
+}
+
+// The response message containing the leases.
+type ListNodeLeasesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// error
+	Error uint32 `protobuf:"varint,1,opt,name=error,proto3" json:"error,omitempty"`
+	// msg
+	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// data ...
+	Data *ListNodeLeasesResponse_Data `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ListNodeLeasesResponse) Reset() {
+	*x = ListNodeLeasesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListNodeLeasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNodeLeasesResponse) ProtoMessage() {}
+
+func (x *ListNodeLeasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNodeLeasesResponse.ProtoReflect.Descriptor instead.
+func (*ListNodeLeasesResponse) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListNodeLeasesResponse) GetError() uint32 {
+	if x != nil {
+		return x.Error
+	}
+	return 0
+}
+
+func (x *ListNodeLeasesResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ListNodeLeasesResponse) GetData() *ListNodeLeasesResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// The detail of the grpc status of a failed rpc.
+type ErrorDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// why the rpc failed
+	Code ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=uuid.v1.ErrorCode" json:"code,omitempty"`
+}
+
+func (x *ErrorDetail) Reset() {
+	*x = ErrorDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrorDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorDetail) ProtoMessage() {}
+
+func (x *ErrorDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorDetail.ProtoReflect.Descriptor instead.
+func (*ErrorDetail) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ErrorDetail) GetCode() ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+// Data ...
+type GetUuidBySnowflakeResponse_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uuid in the format of the request
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// uuid as a number
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetUuidBySnowflakeResponse_Data) Reset() {
+	*x = GetUuidBySnowflakeResponse_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUuidBySnowflakeResponse_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUuidBySnowflakeResponse_Data) ProtoMessage() {}
+
+func (x *GetUuidBySnowflakeResponse_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUuidBySnowflakeResponse_Data.ProtoReflect.Descriptor instead.
+func (*GetUuidBySnowflakeResponse_Data) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *GetUuidBySnowflakeResponse_Data) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *GetUuidBySnowflakeResponse_Data) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Data ...
+type GetUuidBySnowflakeBatchResponse_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uuids in the order they were generated, in the format of the request
+	Uuids []string `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"`
+	// uuids as numbers, in the same order
+	Ids []uint64 `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *GetUuidBySnowflakeBatchResponse_Data) Reset() {
+	*x = GetUuidBySnowflakeBatchResponse_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUuidBySnowflakeBatchResponse_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUuidBySnowflakeBatchResponse_Data) ProtoMessage() {}
+
+func (x *GetUuidBySnowflakeBatchResponse_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUuidBySnowflakeBatchResponse_Data.ProtoReflect.Descriptor instead.
+func (*GetUuidBySnowflakeBatchResponse_Data) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *GetUuidBySnowflakeBatchResponse_Data) GetUuids() []string {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+func (x *GetUuidBySnowflakeBatchResponse_Data) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// Data ...
+type StreamUuidBySnowflakeResponse_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uuids in the order they were generated, in the format of the request
+	Uuids []string `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"`
+	// uuids as numbers, in the same order
+	Ids []uint64 `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *StreamUuidBySnowflakeResponse_Data) Reset() {
+	*x = StreamUuidBySnowflakeResponse_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamUuidBySnowflakeResponse_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamUuidBySnowflakeResponse_Data) ProtoMessage() {}
+
+func (x *StreamUuidBySnowflakeResponse_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamUuidBySnowflakeResponse_Data.ProtoReflect.Descriptor instead.
+func (*StreamUuidBySnowflakeResponse_Data) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *StreamUuidBySnowflakeResponse_Data) GetUuids() []string {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+func (x *StreamUuidBySnowflakeResponse_Data) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// Data ...
+type ParseSnowflakeResponse_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uuid as requested
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// unix milliseconds the uuid was generated at
+	Timestamp int64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// timestamp in RFC 3339
+	Time string `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	// node id of the generator
+	NodeId int64 `protobuf:"varint,4,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// step within the millisecond
+	Step int64 `protobuf:"varint,5,opt,name=step,proto3" json:"step,omitempty"`
+	// datacenter id of the generator, 0 if the layout has no datacenter bits
+	DatacenterId int64 `protobuf:"varint,6,opt,name=datacenter_id,json=datacenterId,proto3" json:"datacenter_id,omitempty"`
+	// region or region/zone of the datacenter id in datacenters of the server
+	Datacenter string `protobuf:"bytes,7,opt,name=datacenter,proto3" json:"datacenter,omitempty"`
+	// uuid decoded to a number
+	Id uint64 `protobuf:"varint,8,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ParseSnowflakeResponse_Data) Reset() {
+	*x = ParseSnowflakeResponse_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParseSnowflakeResponse_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseSnowflakeResponse_Data) ProtoMessage() {}
+
+func (x *ParseSnowflakeResponse_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseSnowflakeResponse_Data.ProtoReflect.Descriptor instead.
+func (*ParseSnowflakeResponse_Data) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *ParseSnowflakeResponse_Data) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ParseSnowflakeResponse_Data) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ParseSnowflakeResponse_Data) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *ParseSnowflakeResponse_Data) GetNodeId() int64 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *ParseSnowflakeResponse_Data) GetStep() int64 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *ParseSnowflakeResponse_Data) GetDatacenterId() int64 {
+	if x != nil {
+		return x.DatacenterId
+	}
+	return 0
+}
+
+func (x *ParseSnowflakeResponse_Data) GetDatacenter() string {
+	if x != nil {
+		return x.Datacenter
+	}
+	return ""
+}
+
+func (x *ParseSnowflakeResponse_Data) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Data ...
+type GetSnowflakeRangeResponse_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// smallest uuid of the window in decimal
+	MinUuid string `protobuf:"bytes,1,opt,name=min_uuid,json=minUuid,proto3" json:"min_uuid,omitempty"`
+	// largest uuid of the window in decimal
+	MaxUuid string `protobuf:"bytes,2,opt,name=max_uuid,json=maxUuid,proto3" json:"max_uuid,omitempty"`
+}
+
+func (x *GetSnowflakeRangeResponse_Data) Reset() {
+	*x = GetSnowflakeRangeResponse_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSnowflakeRangeResponse_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSnowflakeRangeResponse_Data) ProtoMessage() {}
+
+func (x *GetSnowflakeRangeResponse_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSnowflakeRangeResponse_Data.ProtoReflect.Descriptor instead.
+func (*GetSnowflakeRangeResponse_Data) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *GetSnowflakeRangeResponse_Data) GetMinUuid() string {
+	if x != nil {
+		return x.MinUuid
+	}
+	return ""
+}
+
+func (x *GetSnowflakeRangeResponse_Data) GetMaxUuid() string {
+	if x != nil {
+		return x.MaxUuid
+	}
+	return ""
+}
+
+// Data ...
+type GetUuidByGoogleUUIDV4Response_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// message ...
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *GetUuidByGoogleUUIDV4Response_Data) Reset() {
+	*x = GetUuidByGoogleUUIDV4Response_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUuidByGoogleUUIDV4Response_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUuidByGoogleUUIDV4Response_Data) ProtoMessage() {}
+
+func (x *GetUuidByGoogleUUIDV4Response_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUuidByGoogleUUIDV4Response_Data.ProtoReflect.Descriptor instead.
+func (*GetUuidByGoogleUUIDV4Response_Data) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *GetUuidByGoogleUUIDV4Response_Data) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// Data ...
+type GetUuidV7Response_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uuid v7 in the canonical 8-4-4-4-12 form
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *GetUuidV7Response_Data) Reset() {
+	*x = GetUuidV7Response_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUuidV7Response_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUuidV7Response_Data) ProtoMessage() {}
+
+func (x *GetUuidV7Response_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUuidV7Response_Data.ProtoReflect.Descriptor instead.
+func (*GetUuidV7Response_Data) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *GetUuidV7Response_Data) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// Data ...
+type GetUlidResponse_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ulid in 26 characters of crockford base32
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *GetUlidResponse_Data) Reset() {
+	*x = GetUlidResponse_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUlidResponse_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUlidResponse_Data) ProtoMessage() {}
+
+func (x *GetUlidResponse_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUlidResponse_Data.ProtoReflect.Descriptor instead.
+func (*GetUlidResponse_Data) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *GetUlidResponse_Data) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// Data ...
+type GetKsuidResponse_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ksuid in 27 characters of base62
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *GetKsuidResponse_Data) Reset() {
+	*x = GetKsuidResponse_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetKsuidResponse_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKsuidResponse_Data) ProtoMessage() {}
+
+func (x *GetKsuidResponse_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKsuidResponse_Data.ProtoReflect.Descriptor instead.
+func (*GetKsuidResponse_Data) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *GetKsuidResponse_Data) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// Data ...
+type GetUuidByNameResponse_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// message ...
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *GetUuidByNameResponse_Data) Reset() {
+	*x = GetUuidByNameResponse_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUuidByNameResponse_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUuidByNameResponse_Data) ProtoMessage() {}
+
+func (x *GetUuidByNameResponse_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUuidByNameResponse_Data.ProtoReflect.Descriptor instead.
+func (*GetUuidByNameResponse_Data) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{19, 0}
+}
+
+func (x *GetUuidByNameResponse_Data) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// Data ...
+type GetUuidByNameBatchResponse_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uuids in the order of the names
+	Uuids []string `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"`
+}
+
+func (x *GetUuidByNameBatchResponse_Data) Reset() {
+	*x = GetUuidByNameBatchResponse_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUuidByNameBatchResponse_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUuidByNameBatchResponse_Data) ProtoMessage() {}
+
+func (x *GetUuidByNameBatchResponse_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUuidByNameBatchResponse_Data.ProtoReflect.Descriptor instead.
+func (*GetUuidByNameBatchResponse_Data) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *GetUuidByNameBatchResponse_Data) GetUuids() []string {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+// Data ...
+type GetPublicIdResponse_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// public id, the prefix, an underscore, the uuid in base62 and a check character
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetPublicIdResponse_Data) Reset() {
+	*x = GetPublicIdResponse_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPublicIdResponse_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicIdResponse_Data) ProtoMessage() {}
+
+func (x *GetPublicIdResponse_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicIdResponse_Data.ProtoReflect.Descriptor instead.
+func (*GetPublicIdResponse_Data) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{23, 0}
+}
+
+func (x *GetPublicIdResponse_Data) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Data ...
+type ValidatePublicIdResponse_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// whether the prefix is registered and the check character matches
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// error ParsePublicId fails with if the id is not valid, 0 if it is
+	Reason uint32 `protobuf:"varint,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// why the id is not valid
+	Detail string `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+}
+
+func (x *ValidatePublicIdResponse_Data) Reset() {
+	*x = ValidatePublicIdResponse_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatePublicIdResponse_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatePublicIdResponse_Data) ProtoMessage() {}
+
+func (x *ValidatePublicIdResponse_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatePublicIdResponse_Data.ProtoReflect.Descriptor instead.
+func (*ValidatePublicIdResponse_Data) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{25, 0}
+}
+
+func (x *ValidatePublicIdResponse_Data) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidatePublicIdResponse_Data) GetReason() uint32 {
+	if x != nil {
+		return x.Reason
+	}
+	return 0
+}
+
+func (x *ValidatePublicIdResponse_Data) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+// Data ...
+type ParsePublicIdResponse_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// prefix of the public id
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// kind of the uuid: snowflake or uuid_v7
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// snowflake uuid in decimal or uuid v7 in its canonical form
+	Uuid string `protobuf:"bytes,3,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// unix milliseconds the uuid was generated at
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// generator of the snowflake uuid, empty for the one of [jupiter.server.uuid]
+	Generator string `protobuf:"bytes,5,opt,name=generator,proto3" json:"generator,omitempty"`
+}
+
+func (x *ParsePublicIdResponse_Data) Reset() {
+	*x = ParsePublicIdResponse_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParsePublicIdResponse_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParsePublicIdResponse_Data) ProtoMessage() {}
+
+func (x *ParsePublicIdResponse_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParsePublicIdResponse_Data.ProtoReflect.Descriptor instead.
+func (*ParsePublicIdResponse_Data) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{27, 0}
+}
+
+func (x *ParsePublicIdResponse_Data) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ParsePublicIdResponse_Data) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ParsePublicIdResponse_Data) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ParsePublicIdResponse_Data) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ParsePublicIdResponse_Data) GetGenerator() string {
+	if x != nil {
+		return x.Generator
+	}
+	return ""
+}
+
+// Data ...
+type EncodeObfuscatedIdResponse_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// obfuscated id, the key version and the permuted uuid in base62
+	ObfuscatedId string `protobuf:"bytes,1,opt,name=obfuscated_id,json=obfuscatedId,proto3" json:"obfuscated_id,omitempty"`
+	// key version the uuid was obfuscated with
+	KeyVersion uint32 `protobuf:"varint,2,opt,name=key_version,json=keyVersion,proto3" json:"key_version,omitempty"`
+}
+
+func (x *EncodeObfuscatedIdResponse_Data) Reset() {
+	*x = EncodeObfuscatedIdResponse_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EncodeObfuscatedIdResponse_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncodeObfuscatedIdResponse_Data) ProtoMessage() {}
+
+func (x *EncodeObfuscatedIdResponse_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncodeObfuscatedIdResponse_Data.ProtoReflect.Descriptor instead.
+func (*EncodeObfuscatedIdResponse_Data) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{29, 0}
+}
+
+func (x *EncodeObfuscatedIdResponse_Data) GetObfuscatedId() string {
+	if x != nil {
+		return x.ObfuscatedId
+	}
+	return ""
+}
+
+func (x *EncodeObfuscatedIdResponse_Data) GetKeyVersion() uint32 {
+	if x != nil {
+		return x.KeyVersion
+	}
+	return 0
+}
+
+// Data ...
+type DecodeObfuscatedIdResponse_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// snowflake uuid as a number
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// snowflake uuid in decimal
+	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// key version the uuid was obfuscated with
+	KeyVersion uint32 `protobuf:"varint,3,opt,name=key_version,json=keyVersion,proto3" json:"key_version,omitempty"`
+}
+
+func (x *DecodeObfuscatedIdResponse_Data) Reset() {
+	*x = DecodeObfuscatedIdResponse_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodeObfuscatedIdResponse_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeObfuscatedIdResponse_Data) ProtoMessage() {}
+
+func (x *DecodeObfuscatedIdResponse_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeObfuscatedIdResponse_Data.ProtoReflect.Descriptor instead.
+func (*DecodeObfuscatedIdResponse_Data) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{31, 0}
+}
+
+func (x *DecodeObfuscatedIdResponse_Data) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DecodeObfuscatedIdResponse_Data) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *DecodeObfuscatedIdResponse_Data) GetKeyVersion() uint32 {
+	if x != nil {
+		return x.KeyVersion
+	}
+	return 0
+}
+
+// Data ...
+type GetSegmentIdResponse_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id in decimal
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// id as a number
+	Id int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetSegmentIdResponse_Data) Reset() {
+	*x = GetSegmentIdResponse_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSegmentIdResponse_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSegmentIdResponse_Data) ProtoMessage() {}
+
+func (x *GetSegmentIdResponse_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSegmentIdResponse_Data.ProtoReflect.Descriptor instead.
+func (*GetSegmentIdResponse_Data) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{33, 0}
+}
+
+func (x *GetSegmentIdResponse_Data) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *GetSegmentIdResponse_Data) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Data ...
+type GetSequenceResponse_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// number in decimal
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// number as a number
+	Id int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// period the number belongs to, like 2024-05-01 for a daily reset, empty if the sequence never resets
+	Period string `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`
+}
+
+func (x *GetSequenceResponse_Data) Reset() {
+	*x = GetSequenceResponse_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSequenceResponse_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSequenceResponse_Data) ProtoMessage() {}
+
+func (x *GetSequenceResponse_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSequenceResponse_Data.ProtoReflect.Descriptor instead.
+func (*GetSequenceResponse_Data) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{35, 0}
+}
+
+func (x *GetSequenceResponse_Data) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *GetSequenceResponse_Data) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetSequenceResponse_Data) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+// Data ...
+type LeaseNodeIdResponse_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// node id leased to the client
+	NodeId int64 `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// lease to renew and release the node id with
+	Lease string `protobuf:"bytes,2,opt,name=lease,proto3" json:"lease,omitempty"`
+	// milliseconds the lease lasts without a renewal
+	Ttl int64 `protobuf:"varint,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// epoch of the layout in unix milliseconds
+	Epoch int64 `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// bits of the layout
+	DatacenterBits uint32 `protobuf:"varint,5,opt,name=datacenter_bits,json=datacenterBits,proto3" json:"datacenter_bits,omitempty"`
+	NodeBits       uint32 `protobuf:"varint,6,opt,name=node_bits,json=nodeBits,proto3" json:"node_bits,omitempty"`
+	StepBits       uint32 `protobuf:"varint,7,opt,name=step_bits,json=stepBits,proto3" json:"step_bits,omitempty"`
+	// datacenter id of the server, 0 if the layout has no datacenter bits
+	DatacenterId int64 `protobuf:"varint,8,opt,name=datacenter_id,json=datacenterId,proto3" json:"datacenter_id,omitempty"`
+	// high-water mark of the node id in unix milliseconds, the client generates only after it; 0 if there is none
+	Since int64 `protobuf:"varint,9,opt,name=since,proto3" json:"since,omitempty"`
+}
+
+func (x *LeaseNodeIdResponse_Data) Reset() {
+	*x = LeaseNodeIdResponse_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseNodeIdResponse_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseNodeIdResponse_Data) ProtoMessage() {}
+
+func (x *LeaseNodeIdResponse_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseNodeIdResponse_Data.ProtoReflect.Descriptor instead.
+func (*LeaseNodeIdResponse_Data) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{37, 0}
+}
+
+func (x *LeaseNodeIdResponse_Data) GetNodeId() int64 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *LeaseNodeIdResponse_Data) GetLease() string {
+	if x != nil {
+		return x.Lease
+	}
+	return ""
+}
+
+func (x *LeaseNodeIdResponse_Data) GetTtl() int64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+func (x *LeaseNodeIdResponse_Data) GetEpoch() int64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *LeaseNodeIdResponse_Data) GetDatacenterBits() uint32 {
+	if x != nil {
+		return x.DatacenterBits
+	}
+	return 0
+}
+
+func (x *LeaseNodeIdResponse_Data) GetNodeBits() uint32 {
+	if x != nil {
+		return x.NodeBits
+	}
+	return 0
+}
+
+func (x *LeaseNodeIdResponse_Data) GetStepBits() uint32 {
+	if x != nil {
+		return x.StepBits
+	}
+	return 0
+}
+
+func (x *LeaseNodeIdResponse_Data) GetDatacenterId() int64 {
+	if x != nil {
+		return x.DatacenterId
+	}
+	return 0
+}
+
+func (x *LeaseNodeIdResponse_Data) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+// Data ...
+type RenewNodeLeaseResponse_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// milliseconds the lease lasts from now without another renewal
+	Ttl int64 `protobuf:"varint,1,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *RenewNodeLeaseResponse_Data) Reset() {
+	*x = RenewNodeLeaseResponse_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenewNodeLeaseResponse_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewNodeLeaseResponse_Data) ProtoMessage() {}
+
+func (x *RenewNodeLeaseResponse_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewNodeLeaseResponse_Data.ProtoReflect.Descriptor instead.
+func (*RenewNodeLeaseResponse_Data) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{39, 0}
+}
+
+func (x *RenewNodeLeaseResponse_Data) GetTtl() int64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+// Lease ...
+type ListNodeLeasesResponse_Lease struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// leased node id
+	NodeId int64 `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// holder of the lease
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// milliseconds the lease has left
+	Ttl int64 `protobuf:"varint,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// whether the node id is leased to a client rather than held by a server
+	Client bool `protobuf:"varint,4,opt,name=client,proto3" json:"client,omitempty"`
+	// whether the node id is the one of the server that answered
+	Self bool `protobuf:"varint,5,opt,name=self,proto3" json:"self,omitempty"`
+}
+
+func (x *ListNodeLeasesResponse_Lease) Reset() {
+	*x = ListNodeLeasesResponse_Lease{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListNodeLeasesResponse_Lease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNodeLeasesResponse_Lease) ProtoMessage() {}
+
+func (x *ListNodeLeasesResponse_Lease) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNodeLeasesResponse_Lease.ProtoReflect.Descriptor instead.
+func (*ListNodeLeasesResponse_Lease) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{43, 0}
+}
+
+func (x *ListNodeLeasesResponse_Lease) GetNodeId() int64 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *ListNodeLeasesResponse_Lease) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ListNodeLeasesResponse_Lease) GetTtl() int64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+func (x *ListNodeLeasesResponse_Lease) GetClient() bool {
+	if x != nil {
+		return x.Client
+	}
+	return false
+}
+
+func (x *ListNodeLeasesResponse_Lease) GetSelf() bool {
+	if x != nil {
+		return x.Self
+	}
+	return false
+}
+
+// Data ...
+type ListNodeLeasesResponse_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// leases in the order of the node ids
+	Leases []*ListNodeLeasesResponse_Lease `protobuf:"bytes,1,rep,name=leases,proto3" json:"leases,omitempty"`
+}
+
+func (x *ListNodeLeasesResponse_Data) Reset() {
+	*x = ListNodeLeasesResponse_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uuid_v1_uuid_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListNodeLeasesResponse_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNodeLeasesResponse_Data) ProtoMessage() {}
+
+func (x *ListNodeLeasesResponse_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_uuid_v1_uuid_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNodeLeasesResponse_Data.ProtoReflect.Descriptor instead.
+func (*ListNodeLeasesResponse_Data) Descriptor() ([]byte, []int) {
+	return file_uuid_v1_uuid_proto_rawDescGZIP(), []int{43, 1}
+}
+
+func (x *ListNodeLeasesResponse_Data) GetLeases() []*ListNodeLeasesResponse_Lease {
+	if x != nil {
+		return x.Leases
+	}
+	return nil
+}
+
+var File_uuid_v1_uuid_proto protoreflect.FileDescriptor
+
+var file_uuid_v1_uuid_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x75, 0x75, 0x69, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x7a, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79, 0x53, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x27,
+	0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0xae, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x55,
+	0x75, 0x69, 0x64, 0x42, 0x79, 0x53, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x3c,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x75,
+	0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79,
+	0x53, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x2a, 0x0a, 0x04,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x95, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74,
+	0x55, 0x75, 0x69, 0x64, 0x42, 0x79, 0x53, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79,
+	0x22, 0xbc, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79, 0x53, 0x6e,
+	0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x41, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x75, 0x75, 0x69,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79, 0x53, 0x6e,
+	0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a,
+	0x2e, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x75, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x75, 0x75, 0x69, 0x64, 0x73, 0x12, 0x10, 0x0a,
+	0x03, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22,
+	0x8b, 0x01, 0x0a, 0x1c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79,
+	0x53, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x22, 0xb8, 0x01,
+	0x0a, 0x1d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79, 0x53, 0x6e,
+	0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x3f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79, 0x53, 0x6e, 0x6f, 0x77,
+	0x66, 0x6c, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x2e, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x14, 0x0a, 0x05, 0x75, 0x75, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x75, 0x75, 0x69, 0x64, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x04, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x61, 0x0a, 0x15, 0x50, 0x61, 0x72, 0x73,
+	0x65, 0x53, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0xcb, 0x02, 0x0a, 0x16,
+	0x50, 0x61, 0x72, 0x73, 0x65, 0x53, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x38,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x75,
+	0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x53, 0x6e, 0x6f, 0x77,
+	0x66, 0x6c, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0xce, 0x01, 0x0a, 0x04, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x73, 0x74, 0x65, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x63, 0x65, 0x6e, 0x74,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x64, 0x61, 0x74,
+	0x61, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x74,
+	0x61, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64,
+	0x61, 0x74, 0x61, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x6a, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x53, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x15, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xbe, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x6f,
+	0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x3b, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x75, 0x75, 0x69, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3c, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x19, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x55, 0x75, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6d,
+	0x61, 0x78, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x61, 0x78, 0x55, 0x75, 0x69, 0x64, 0x22, 0x1e, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x55, 0x75, 0x69,
+	0x64, 0x42, 0x79, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x55, 0x55, 0x49, 0x44, 0x56, 0x34, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa4, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x55, 0x75,
+	0x69, 0x64, 0x42, 0x79, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x55, 0x55, 0x49, 0x44, 0x56, 0x34,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67,
+	0x12, 0x3f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64,
+	0x42, 0x79, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x55, 0x55, 0x49, 0x44, 0x56, 0x34, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x1a, 0x1a, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x12, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x56, 0x37, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x8c, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x56, 0x37, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x10, 0x0a,
+	0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12,
+	0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x56,
+	0x37, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x1a, 0x1a, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x88, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x6c, 0x69, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x31,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x75,
+	0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6c, 0x69, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x1a, 0x1a, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x11, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x4b, 0x73, 0x75, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x8a, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4b, 0x73, 0x75, 0x69, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x32, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x75, 0x75,
+	0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x73, 0x75, 0x69, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x1a, 0x1a, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x62, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x94, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6d, 0x73, 0x67, 0x12, 0x37, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x75, 0x69, 0x64, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x1a, 0x0a, 0x04,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x69, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x55,
+	0x75, 0x69, 0x64, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x22, 0xa0, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x42,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x3c, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x1c, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x14, 0x0a, 0x05, 0x75, 0x75, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x75, 0x75, 0x69, 0x64, 0x73, 0x22, 0x2c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x22, 0x8c, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6d, 0x73, 0x67, 0x12, 0x35, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x16, 0x0a, 0x04, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x29, 0x0a, 0x17, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xcc,
+	0x01, 0x0a, 0x18, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6d, 0x73, 0x67, 0x12, 0x3a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a,
+	0x4c, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x26, 0x0a,
+	0x14, 0x50, 0x61, 0x72, 0x73, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xfd, 0x01, 0x0a, 0x15, 0x50, 0x61, 0x72, 0x73, 0x65, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x37, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x61, 0x72, 0x73, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x1a, 0x82, 0x01, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x2b, 0x0a, 0x19, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x4f,
+	0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x64, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0xd0, 0x01, 0x0a, 0x1a, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x4f, 0x62, 0x66,
+	0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x64, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x3c, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x4c, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x23, 0x0a, 0x0d, 0x6f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x65, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6b, 0x65, 0x79, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x36, 0x0a, 0x19, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x4f,
+	0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x64, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x0d, 0x6f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xcf, 0x01,
+	0x0a, 0x1a, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6d, 0x73, 0x67, 0x12, 0x3c, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63,
+	0x6f, 0x64, 0x65, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x64, 0x49, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x1a, 0x4b, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6b, 0x65, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6b, 0x65, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x2e, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x69, 0x7a, 0x5f, 0x74, 0x61,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x7a, 0x54, 0x61, 0x67, 0x22,
+	0xa2, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67,
+	0x12, 0x36, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x2a, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x26, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0xb8, 0x01, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x35, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x75, 0x75, 0x69,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x1a, 0x42, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x48, 0x0a, 0x12, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x22, 0xf2, 0x02, 0x0a, 0x13, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x49,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73,
+	0x67, 0x12, 0x35, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x4e,
+	0x6f, 0x64, 0x65, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0xfb, 0x01, 0x0a, 0x04, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x74,
+	0x74, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x61, 0x74, 0x61,
+	0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x5f, 0x62, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x42, 0x69, 0x74,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x62, 0x69, 0x74, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x42, 0x69, 0x74, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x62, 0x69, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x73, 0x74, 0x65, 0x70, 0x42, 0x69, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x64,
+	0x61, 0x74, 0x61, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x22, 0x46, 0x0a, 0x15, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x4e,
+	0x6f, 0x64, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x22, 0x94,
+	0x01, 0x0a, 0x16, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73,
+	0x67, 0x12, 0x38, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x4e,
+	0x6f, 0x64, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x18, 0x0a, 0x04, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x5c, 0x0a, 0x17, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6c,
+	0x61, 0x73, 0x74, 0x22, 0x42, 0x0a, 0x18, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x6f,
+	0x64, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x17, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4e,
+	0x6f, 0x64, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0xb7, 0x02, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6d, 0x73, 0x67, 0x12, 0x38, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x74, 0x0a,
+	0x05, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x65, 0x6c, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73,
+	0x65, 0x6c, 0x66, 0x1a, 0x45, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3d, 0x0a, 0x06, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x75, 0x75,
+	0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x22, 0x35, 0x0a, 0x0b, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x26, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x2a, 0xb4, 0x0a, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x1a, 0x0a, 0x16, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x28, 0x0a, 0x23, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x43, 0x5f, 0x49, 0x44, 0x5f, 0x50, 0x52, 0x45, 0x46,
+	0x49, 0x58, 0x10, 0x91, 0x4e, 0x12, 0x2b, 0x0a, 0x26, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43,
+	0x4f, 0x44, 0x45, 0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x43, 0x5f, 0x49, 0x44, 0x5f, 0x43, 0x48,
+	0x45, 0x43, 0x4b, 0x53, 0x55, 0x4d, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10,
+	0x92, 0x4e, 0x12, 0x2f, 0x0a, 0x2a, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45,
+	0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x4f, 0x42, 0x46, 0x55, 0x53, 0x43, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e,
+	0x10, 0x93, 0x4e, 0x12, 0x26, 0x0a, 0x21, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44,
+	0x45, 0x5f, 0x49, 0x44, 0x45, 0x4d, 0x50, 0x4f, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x4b, 0x45,
+	0x59, 0x5f, 0x52, 0x45, 0x55, 0x53, 0x45, 0x44, 0x10, 0x94, 0x4e, 0x12, 0x1f, 0x0a, 0x1a, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x95, 0x4e, 0x12, 0x18, 0x0a, 0x13,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52,
+	0x4e, 0x41, 0x4c, 0x10, 0x96, 0x4e, 0x12, 0x1d, 0x0a, 0x18, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f,
+	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x52, 0x4f, 0x55,
+	0x54, 0x45, 0x10, 0x97, 0x4e, 0x12, 0x22, 0x0a, 0x1d, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43,
+	0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x45,
+	0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x98, 0x4e, 0x12, 0x21, 0x0a, 0x1c, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x44, 0x5f,
+	0x45, 0x58, 0x48, 0x41, 0x55, 0x53, 0x54, 0x45, 0x44, 0x10, 0x99, 0x4e, 0x12, 0x1e, 0x0a, 0x19,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x43, 0x4b,
+	0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x9a, 0x4e, 0x12, 0x2c, 0x0a, 0x27,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x43, 0x4b,
+	0x5f, 0x42, 0x45, 0x48, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x5f, 0x57, 0x41, 0x54,
+	0x45, 0x52, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x10, 0x9b, 0x4e, 0x12, 0x21, 0x0a, 0x1c, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x9c, 0x4e, 0x12, 0x1f, 0x0a,
+	0x1a, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45,
+	0x52, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x9d, 0x4e, 0x12, 0x21,
+	0x0a, 0x1c, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x10, 0x9e,
+	0x4e, 0x12, 0x24, 0x0a, 0x1f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x55, 0x55, 0x49, 0x44, 0x5f, 0x56, 0x45, 0x52,
+	0x53, 0x49, 0x4f, 0x4e, 0x10, 0x9f, 0x4e, 0x12, 0x23, 0x0a, 0x1e, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x42, 0x41,
+	0x54, 0x43, 0x48, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0xa0, 0x4e, 0x12, 0x1e, 0x0a, 0x19,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x10, 0xa1, 0x4e, 0x12, 0x21, 0x0a, 0x1c,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x5f, 0x53, 0x4e, 0x4f, 0x57, 0x46, 0x4c, 0x41, 0x4b, 0x45, 0x10, 0xa2, 0x4e, 0x12,
+	0x23, 0x0a, 0x1e, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f,
+	0x57, 0x10, 0xa3, 0x4e, 0x12, 0x21, 0x0a, 0x1c, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f,
+	0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49,
+	0x43, 0x5f, 0x49, 0x44, 0x10, 0xa4, 0x4e, 0x12, 0x25, 0x0a, 0x20, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4f, 0x42,
+	0x46, 0x55, 0x53, 0x43, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x49, 0x44, 0x10, 0xa5, 0x4e, 0x12, 0x24,
+	0x0a, 0x1f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4f, 0x42, 0x46,
+	0x55, 0x53, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45,
+	0x44, 0x10, 0xa6, 0x4e, 0x12, 0x20, 0x0a, 0x1b, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f,
+	0x44, 0x45, 0x5f, 0x53, 0x45, 0x47, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42,
+	0x4c, 0x45, 0x44, 0x10, 0xa7, 0x4e, 0x12, 0x1f, 0x0a, 0x1a, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f,
+	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x42, 0x49, 0x5a,
+	0x5f, 0x54, 0x41, 0x47, 0x10, 0xa8, 0x4e, 0x12, 0x23, 0x0a, 0x1e, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x45, 0x47, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e,
+	0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0xa9, 0x4e, 0x12, 0x20, 0x0a, 0x1b,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x5f, 0x53, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x45, 0x10, 0xaa, 0x4e, 0x12, 0x24,
+	0x0a, 0x1f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x45, 0x51,
+	0x55, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c,
+	0x45, 0x10, 0xab, 0x4e, 0x12, 0x24, 0x0a, 0x1f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f,
+	0x44, 0x45, 0x5f, 0x49, 0x44, 0x45, 0x4d, 0x50, 0x4f, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x44,
+	0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0xac, 0x4e, 0x12, 0x27, 0x0a, 0x22, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x5f, 0x49, 0x44, 0x45, 0x4d, 0x50, 0x4f, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x4b, 0x45, 0x59,
+	0x10, 0xad, 0x4e, 0x12, 0x26, 0x0a, 0x21, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44,
+	0x45, 0x5f, 0x49, 0x44, 0x45, 0x4d, 0x50, 0x4f, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x53, 0x54,
+	0x4f, 0x52, 0x45, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0xae, 0x4e, 0x12, 0x27, 0x0a, 0x22, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x44, 0x45, 0x4d, 0x50, 0x4f,
+	0x54, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c,
+	0x45, 0x10, 0xaf, 0x4e, 0x12, 0x24, 0x0a, 0x1f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f,
+	0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x53, 0x5f, 0x44,
+	0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0xb0, 0x4e, 0x12, 0x23, 0x0a, 0x1e, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x5f, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x4f, 0x57, 0x4e, 0x45, 0x52, 0x10, 0xb1, 0x4e, 0x12,
+	0x1f, 0x0a, 0x1a, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f,
+	0x44, 0x45, 0x5f, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x4c, 0x4f, 0x53, 0x54, 0x10, 0xb2, 0x4e,
+	0x12, 0x26, 0x0a, 0x21, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e,
+	0x4f, 0x44, 0x45, 0x5f, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49,
+	0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0xb3, 0x4e, 0x32, 0x8d, 0x12, 0x0a, 0x0b, 0x55, 0x75, 0x69,
+	0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x76, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55,
+	0x75, 0x69, 0x64, 0x42, 0x79, 0x53, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x12, 0x22,
+	0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64,
+	0x42, 0x79, 0x53, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x75, 0x69, 0x64, 0x42, 0x79, 0x53, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12,
+	0x0f, 0x2f, 0x73, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64,
+	0x12, 0x8b, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79, 0x53, 0x6e,
+	0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x27, 0x2e, 0x75,
+	0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79,
+	0x53, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79, 0x53, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61,
+	0x6b, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x73, 0x6e, 0x6f, 0x77, 0x66, 0x6c,
+	0x61, 0x6b, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0x6c,
+	0x0a, 0x15, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79, 0x53, 0x6e,
+	0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x12, 0x25, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79, 0x53, 0x6e,
+	0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55,
+	0x75, 0x69, 0x64, 0x42, 0x79, 0x53, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x70, 0x0a, 0x0e,
+	0x50, 0x61, 0x72, 0x73, 0x65, 0x53, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x12, 0x1e,
+	0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x53, 0x6e,
+	0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x53, 0x6e,
+	0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x73, 0x6e, 0x6f, 0x77, 0x66, 0x6c,
+	0x61, 0x6b, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x2f, 0x70, 0x61, 0x72, 0x73, 0x65, 0x12, 0x79,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x21, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x17, 0x12, 0x15, 0x2f, 0x73, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x5f, 0x75,
+	0x75, 0x69, 0x64, 0x2f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x7f, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x55, 0x75, 0x69, 0x64, 0x42, 0x79, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x55, 0x55, 0x49, 0x44,
+	0x56, 0x34, 0x12, 0x25, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x75, 0x69, 0x64, 0x42, 0x79, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x55, 0x55, 0x49, 0x44,
+	0x56, 0x34, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x75, 0x75, 0x69, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79, 0x47, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x55, 0x55, 0x49, 0x44, 0x56, 0x34, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x5f, 0x76, 0x34, 0x12, 0x62, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x2e, 0x75, 0x75,
+	0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x75, 0x75, 0x69,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x12, 0x7a,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x22, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x5f,
+	0x75, 0x75, 0x69, 0x64, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0x54, 0x0a, 0x09, 0x47, 0x65,
+	0x74, 0x55, 0x75, 0x69, 0x64, 0x56, 0x37, 0x12, 0x19, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x56, 0x37, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x75, 0x69, 0x64, 0x56, 0x37, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x10,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0a, 0x12, 0x08, 0x2f, 0x75, 0x75, 0x69, 0x64, 0x5f, 0x76, 0x37,
+	0x12, 0x4b, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x6c, 0x69, 0x64, 0x12, 0x17, 0x2e, 0x75, 0x75,
+	0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x55, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x07, 0x12, 0x05, 0x2f, 0x75, 0x6c, 0x69, 0x64, 0x12, 0x4f, 0x0a,
+	0x08, 0x47, 0x65, 0x74, 0x4b, 0x73, 0x75, 0x69, 0x64, 0x12, 0x18, 0x2e, 0x75, 0x75, 0x69, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x73, 0x75, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4b, 0x73, 0x75, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0e,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x08, 0x12, 0x06, 0x2f, 0x6b, 0x73, 0x75, 0x69, 0x64, 0x12, 0x5c,
+	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x12, 0x1b, 0x2e,
+	0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x75, 0x75, 0x69,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c,
+	0x12, 0x0a, 0x2f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x12, 0x74, 0x0a, 0x10,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64,
+	0x12, 0x20, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x68, 0x0a, 0x0d, 0x50, 0x61, 0x72, 0x73, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x49, 0x64, 0x12, 0x1d, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
+	0x72, 0x73, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72,
+	0x73, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x2f, 0x70, 0x61, 0x72, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x12,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x49, 0x64, 0x12, 0x22, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x64, 0x49, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x6f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x69, 0x64, 0x2f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x7c, 0x0a, 0x12, 0x44, 0x65,
+	0x63, 0x6f, 0x64, 0x65, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x64, 0x49, 0x64,
+	0x12, 0x22, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64,
+	0x65, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x64, 0x49, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x63, 0x6f, 0x64, 0x65, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x64, 0x49,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x17, 0x12, 0x15, 0x2f, 0x6f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x69,
+	0x64, 0x2f, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x60, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f,
+	0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x12, 0x5b, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x2e, 0x75, 0x75, 0x69, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x4e, 0x6f, 0x64, 0x65,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x1e, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x6e, 0x65, 0x77, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x6e, 0x65, 0x77, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x10, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x20, 0x2e, 0x75,
+	0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x6f,
+	0x64, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4c,
+	0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x65, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x2e,
+	0x75, 0x75, 0x69, 0x64, 0x2e, 0x76, 0x31, 0x42, 0x09, 0x55, 0x75, 0x69, 0x64, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x0e, 0x75, 0x75, 0x69, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x75, 0x75,
+	0x69, 0x64, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x55, 0x58, 0x58, 0xaa, 0x02, 0x07, 0x55, 0x75, 0x69,
+	0x64, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x07, 0x55, 0x75, 0x69, 0x64, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x13, 0x55, 0x75, 0x69, 0x64, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x08, 0x55, 0x75, 0x69, 0x64, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_uuid_v1_uuid_proto_rawDescOnce sync.Once
+	file_uuid_v1_uuid_proto_rawDescData = file_uuid_v1_uuid_proto_rawDesc
+)
+
+func file_uuid_v1_uuid_proto_rawDescGZIP() []byte {
+	file_uuid_v1_uuid_proto_rawDescOnce.Do(func() {
+		file_uuid_v1_uuid_proto_rawDescData = protoimpl.X.CompressGZIP(file_uuid_v1_uuid_proto_rawDescData)
+	})
+	return file_uuid_v1_uuid_proto_rawDescData
+}
+
+var file_uuid_v1_uuid_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_uuid_v1_uuid_proto_msgTypes = make([]protoimpl.MessageInfo, 67)
+var file_uuid_v1_uuid_proto_goTypes = []interface{}{
+	(ErrorCode)(0),                               // 0: uuid.v1.ErrorCode
+	(*GetUuidBySnowflakeRequest)(nil),            // 1: uuid.v1.GetUuidBySnowflakeRequest
+	(*GetUuidBySnowflakeResponse)(nil),           // 2: uuid.v1.GetUuidBySnowflakeResponse
+	(*GetUuidBySnowflakeBatchRequest)(nil),       // 3: uuid.v1.GetUuidBySnowflakeBatchRequest
+	(*GetUuidBySnowflakeBatchResponse)(nil),      // 4: uuid.v1.GetUuidBySnowflakeBatchResponse
+	(*StreamUuidBySnowflakeRequest)(nil),         // 5: uuid.v1.StreamUuidBySnowflakeRequest
+	(*StreamUuidBySnowflakeResponse)(nil),        // 6: uuid.v1.StreamUuidBySnowflakeResponse
+	(*ParseSnowflakeRequest)(nil),                // 7: uuid.v1.ParseSnowflakeRequest
+	(*ParseSnowflakeResponse)(nil),               // 8: uuid.v1.ParseSnowflakeResponse
+	(*GetSnowflakeRangeRequest)(nil),             // 9: uuid.v1.GetSnowflakeRangeRequest
+	(*GetSnowflakeRangeResponse)(nil),            // 10: uuid.v1.GetSnowflakeRangeResponse
+	(*GetUuidByGoogleUUIDV4Request)(nil),         // 11: uuid.v1.GetUuidByGoogleUUIDV4Request
+	(*GetUuidByGoogleUUIDV4Response)(nil),        // 12: uuid.v1.GetUuidByGoogleUUIDV4Response
+	(*GetUuidV7Request)(nil),                     // 13: uuid.v1.GetUuidV7Request
+	(*GetUuidV7Response)(nil),                    // 14: uuid.v1.GetUuidV7Response
+	(*GetUlidRequest)(nil),                       // 15: uuid.v1.GetUlidRequest
+	(*GetUlidResponse)(nil),                      // 16: uuid.v1.GetUlidResponse
+	(*GetKsuidRequest)(nil),                      // 17: uuid.v1.GetKsuidRequest
+	(*GetKsuidResponse)(nil),                     // 18: uuid.v1.GetKsuidResponse
+	(*GetUuidByNameRequest)(nil),                 // 19: uuid.v1.GetUuidByNameRequest
+	(*GetUuidByNameResponse)(nil),                // 20: uuid.v1.GetUuidByNameResponse
+	(*GetUuidByNameBatchRequest)(nil),            // 21: uuid.v1.GetUuidByNameBatchRequest
+	(*GetUuidByNameBatchResponse)(nil),           // 22: uuid.v1.GetUuidByNameBatchResponse
+	(*GetPublicIdRequest)(nil),                   // 23: uuid.v1.GetPublicIdRequest
+	(*GetPublicIdResponse)(nil),                  // 24: uuid.v1.GetPublicIdResponse
+	(*ValidatePublicIdRequest)(nil),              // 25: uuid.v1.ValidatePublicIdRequest
+	(*ValidatePublicIdResponse)(nil),             // 26: uuid.v1.ValidatePublicIdResponse
+	(*ParsePublicIdRequest)(nil),                 // 27: uuid.v1.ParsePublicIdRequest
+	(*ParsePublicIdResponse)(nil),                // 28: uuid.v1.ParsePublicIdResponse
+	(*EncodeObfuscatedIdRequest)(nil),            // 29: uuid.v1.EncodeObfuscatedIdRequest
+	(*EncodeObfuscatedIdResponse)(nil),           // 30: uuid.v1.EncodeObfuscatedIdResponse
+	(*DecodeObfuscatedIdRequest)(nil),            // 31: uuid.v1.DecodeObfuscatedIdRequest
+	(*DecodeObfuscatedIdResponse)(nil),           // 32: uuid.v1.DecodeObfuscatedIdResponse
+	(*GetSegmentIdRequest)(nil),                  // 33: uuid.v1.GetSegmentIdRequest
+	(*GetSegmentIdResponse)(nil),                 // 34: uuid.v1.GetSegmentIdResponse
+	(*GetSequenceRequest)(nil),                   // 35: uuid.v1.GetSequenceRequest
+	(*GetSequenceResponse)(nil),                  // 36: uuid.v1.GetSequenceResponse
+	(*LeaseNodeIdRequest)(nil),                   // 37: uuid.v1.LeaseNodeIdRequest
+	(*LeaseNodeIdResponse)(nil),                  // 38: uuid.v1.LeaseNodeIdResponse
+	(*RenewNodeLeaseRequest)(nil),                // 39: uuid.v1.RenewNodeLeaseRequest
+	(*RenewNodeLeaseResponse)(nil),               // 40: uuid.v1.RenewNodeLeaseResponse
+	(*ReleaseNodeLeaseRequest)(nil),              // 41: uuid.v1.ReleaseNodeLeaseRequest
+	(*ReleaseNodeLeaseResponse)(nil),             // 42: uuid.v1.ReleaseNodeLeaseResponse
+	(*ListNodeLeasesRequest)(nil),                // 43: uuid.v1.ListNodeLeasesRequest
+	(*ListNodeLeasesResponse)(nil),               // 44: uuid.v1.ListNodeLeasesResponse
+	(*ErrorDetail)(nil),                          // 45: uuid.v1.ErrorDetail
+	(*GetUuidBySnowflakeResponse_Data)(nil),      // 46: uuid.v1.GetUuidBySnowflakeResponse.Data
+	(*GetUuidBySnowflakeBatchResponse_Data)(nil), // 47: uuid.v1.GetUuidBySnowflakeBatchResponse.Data
+	(*StreamUuidBySnowflakeResponse_Data)(nil),   // 48: uuid.v1.StreamUuidBySnowflakeResponse.Data
+	(*ParseSnowflakeResponse_Data)(nil),          // 49: uuid.v1.ParseSnowflakeResponse.Data
+	(*GetSnowflakeRangeResponse_Data)(nil),       // 50: uuid.v1.GetSnowflakeRangeResponse.Data
+	(*GetUuidByGoogleUUIDV4Response_Data)(nil),   // 51: uuid.v1.GetUuidByGoogleUUIDV4Response.Data
+	(*GetUuidV7Response_Data)(nil),               // 52: uuid.v1.GetUuidV7Response.Data
+	(*GetUlidResponse_Data)(nil),                 // 53: uuid.v1.GetUlidResponse.Data
+	(*GetKsuidResponse_Data)(nil),                // 54: uuid.v1.GetKsuidResponse.Data
+	(*GetUuidByNameResponse_Data)(nil),           // 55: uuid.v1.GetUuidByNameResponse.Data
+	(*GetUuidByNameBatchResponse_Data)(nil),      // 56: uuid.v1.GetUuidByNameBatchResponse.Data
+	(*GetPublicIdResponse_Data)(nil),             // 57: uuid.v1.GetPublicIdResponse.Data
+	(*ValidatePublicIdResponse_Data)(nil),        // 58: uuid.v1.ValidatePublicIdResponse.Data
+	(*ParsePublicIdResponse_Data)(nil),           // 59: uuid.v1.ParsePublicIdResponse.Data
+	(*EncodeObfuscatedIdResponse_Data)(nil),      // 60: uuid.v1.EncodeObfuscatedIdResponse.Data
+	(*DecodeObfuscatedIdResponse_Data)(nil),      // 61: uuid.v1.DecodeObfuscatedIdResponse.Data
+	(*GetSegmentIdResponse_Data)(nil),            // 62: uuid.v1.GetSegmentIdResponse.Data
+	(*GetSequenceResponse_Data)(nil),             // 63: uuid.v1.GetSequenceResponse.Data
+	(*LeaseNodeIdResponse_Data)(nil),             // 64: uuid.v1.LeaseNodeIdResponse.Data
+	(*RenewNodeLeaseResponse_Data)(nil),          // 65: uuid.v1.RenewNodeLeaseResponse.Data
+	(*ListNodeLeasesResponse_Lease)(nil),         // 66: uuid.v1.ListNodeLeasesResponse.Lease
+	(*ListNodeLeasesResponse_Data)(nil),          // 67: uuid.v1.ListNodeLeasesResponse.Data
+}
+var file_uuid_v1_uuid_proto_depIdxs = []int32{
+	46, // 0: uuid.v1.GetUuidBySnowflakeResponse.data:type_name -> uuid.v1.GetUuidBySnowflakeResponse.Data
+	47, // 1: uuid.v1.GetUuidBySnowflakeBatchResponse.data:type_name -> uuid.v1.GetUuidBySnowflakeBatchResponse.Data
+	48, // 2: uuid.v1.StreamUuidBySnowflakeResponse.data:type_name -> uuid.v1.StreamUuidBySnowflakeResponse.Data
+	49, // 3: uuid.v1.ParseSnowflakeResponse.data:type_name -> uuid.v1.ParseSnowflakeResponse.Data
+	50, // 4: uuid.v1.GetSnowflakeRangeResponse.data:type_name -> uuid.v1.GetSnowflakeRangeResponse.Data
+	51, // 5: uuid.v1.GetUuidByGoogleUUIDV4Response.data:type_name -> uuid.v1.GetUuidByGoogleUUIDV4Response.Data
+	52, // 6: uuid.v1.GetUuidV7Response.data:type_name -> uuid.v1.GetUuidV7Response.Data
+	53, // 7: uuid.v1.GetUlidResponse.data:type_name -> uuid.v1.GetUlidResponse.Data
+	54, // 8: uuid.v1.GetKsuidResponse.data:type_name -> uuid.v1.GetKsuidResponse.Data
+	55, // 9: uuid.v1.GetUuidByNameResponse.data:type_name -> uuid.v1.GetUuidByNameResponse.Data
+	56, // 10: uuid.v1.GetUuidByNameBatchResponse.data:type_name -> uuid.v1.GetUuidByNameBatchResponse.Data
+	57, // 11: uuid.v1.GetPublicIdResponse.data:type_name -> uuid.v1.GetPublicIdResponse.Data
+	58, // 12: uuid.v1.ValidatePublicIdResponse.data:type_name -> uuid.v1.ValidatePublicIdResponse.Data
+	59, // 13: uuid.v1.ParsePublicIdResponse.data:type_name -> uuid.v1.ParsePublicIdResponse.Data
+	60, // 14: uuid.v1.EncodeObfuscatedIdResponse.data:type_name -> uuid.v1.EncodeObfuscatedIdResponse.Data
+	61, // 15: uuid.v1.DecodeObfuscatedIdResponse.data:type_name -> uuid.v1.DecodeObfuscatedIdResponse.Data
+	62, // 16: uuid.v1.GetSegmentIdResponse.data:type_name -> uuid.v1.GetSegmentIdResponse.Data
+	63, // 17: uuid.v1.GetSequenceResponse.data:type_name -> uuid.v1.GetSequenceResponse.Data
+	64, // 18: uuid.v1.LeaseNodeIdResponse.data:type_name -> uuid.v1.LeaseNodeIdResponse.Data
+	65, // 19: uuid.v1.RenewNodeLeaseResponse.data:type_name -> uuid.v1.RenewNodeLeaseResponse.Data
+	67, // 20: uuid.v1.ListNodeLeasesResponse.data:type_name -> uuid.v1.ListNodeLeasesResponse.Data
+	0,  // 21: uuid.v1.ErrorDetail.code:type_name -> uuid.v1.ErrorCode
+	66, // 22: uuid.v1.ListNodeLeasesResponse.Data.leases:type_name -> uuid.v1.ListNodeLeasesResponse.Lease
+	1,  // 23: uuid.v1.UuidService.GetUuidBySnowflake:input_type -> uuid.v1.GetUuidBySnowflakeRequest
+	3,  // 24: uuid.v1.UuidService.GetUuidBySnowflakeBatch:input_type -> uuid.v1.GetUuidBySnowflakeBatchRequest
+	5,  // 25: uuid.v1.UuidService.StreamUuidBySnowflake:input_type -> uuid.v1.StreamUuidBySnowflakeRequest
+	7,  // 26: uuid.v1.UuidService.ParseSnowflake:input_type -> uuid.v1.ParseSnowflakeRequest
+	9,  // 27: uuid.v1.UuidService.GetSnowflakeRange:input_type -> uuid.v1.GetSnowflakeRangeRequest
+	11, // 28: uuid.v1.UuidService.GetUuidByGoogleUUIDV4:input_type -> uuid.v1.GetUuidByGoogleUUIDV4Request
+	19, // 29: uuid.v1.UuidService.GetUuidByName:input_type -> uuid.v1.GetUuidByNameRequest
+	21, // 30: uuid.v1.UuidService.GetUuidByNameBatch:input_type -> uuid.v1.GetUuidByNameBatchRequest
+	13, // 31: uuid.v1.UuidService.GetUuidV7:input_type -> uuid.v1.GetUuidV7Request
+	15, // 32: uuid.v1.UuidService.GetUlid:input_type -> uuid.v1.GetUlidRequest
+	17, // 33: uuid.v1.UuidService.GetKsuid:input_type -> uuid.v1.GetKsuidRequest
+	23, // 34: uuid.v1.UuidService.GetPublicId:input_type -> uuid.v1.GetPublicIdRequest
+	25, // 35: uuid.v1.UuidService.ValidatePublicId:input_type -> uuid.v1.ValidatePublicIdRequest
+	27, // 36: uuid.v1.UuidService.ParsePublicId:input_type -> uuid.v1.ParsePublicIdRequest
+	29, // 37: uuid.v1.UuidService.EncodeObfuscatedId:input_type -> uuid.v1.EncodeObfuscatedIdRequest
+	31, // 38: uuid.v1.UuidService.DecodeObfuscatedId:input_type -> uuid.v1.DecodeObfuscatedIdRequest
+	33, // 39: uuid.v1.UuidService.GetSegmentId:input_type -> uuid.v1.GetSegmentIdRequest
+	35, // 40: uuid.v1.UuidService.GetSequence:input_type -> uuid.v1.GetSequenceRequest
+	37, // 41: uuid.v1.UuidService.LeaseNodeId:input_type -> uuid.v1.LeaseNodeIdRequest
+	39, // 42: uuid.v1.UuidService.RenewNodeLease:input_type -> uuid.v1.RenewNodeLeaseRequest
+	41, // 43: uuid.v1.UuidService.ReleaseNodeLease:input_type -> uuid.v1.ReleaseNodeLeaseRequest
+	43, // 44: uuid.v1.UuidService.ListNodeLeases:input_type -> uuid.v1.ListNodeLeasesRequest
+	2,  // 45: uuid.v1.UuidService.GetUuidBySnowflake:output_type -> uuid.v1.GetUuidBySnowflakeResponse
+	4,  // 46: uuid.v1.UuidService.GetUuidBySnowflakeBatch:output_type -> uuid.v1.GetUuidBySnowflakeBatchResponse
+	6,  // 47: uuid.v1.UuidService.StreamUuidBySnowflake:output_type -> uuid.v1.StreamUuidBySnowflakeResponse
+	8,  // 48: uuid.v1.UuidService.ParseSnowflake:output_type -> uuid.v1.ParseSnowflakeResponse
+	10, // 49: uuid.v1.UuidService.GetSnowflakeRange:output_type -> uuid.v1.GetSnowflakeRangeResponse
+	12, // 50: uuid.v1.UuidService.GetUuidByGoogleUUIDV4:output_type -> uuid.v1.GetUuidByGoogleUUIDV4Response
+	20, // 51: uuid.v1.UuidService.GetUuidByName:output_type -> uuid.v1.GetUuidByNameResponse
+	22, // 52: uuid.v1.UuidService.GetUuidByNameBatch:output_type -> uuid.v1.GetUuidByNameBatchResponse
+	14, // 53: uuid.v1.UuidService.GetUuidV7:output_type -> uuid.v1.GetUuidV7Response
+	16, // 54: uuid.v1.UuidService.GetUlid:output_type -> uuid.v1.GetUlidResponse
+	18, // 55: uuid.v1.UuidService.GetKsuid:output_type -> uuid.v1.GetKsuidResponse
+	24, // 56: uuid.v1.UuidService.GetPublicId:output_type -> uuid.v1.GetPublicIdResponse
+	26, // 57: uuid.v1.UuidService.ValidatePublicId:output_type -> uuid.v1.ValidatePublicIdResponse
+	28, // 58: uuid.v1.UuidService.ParsePublicId:output_type -> uuid.v1.ParsePublicIdResponse
+	30, // 59: uuid.v1.UuidService.EncodeObfuscatedId:output_type -> uuid.v1.EncodeObfuscatedIdResponse
+	32, // 60: uuid.v1.UuidService.DecodeObfuscatedId:output_type -> uuid.v1.DecodeObfuscatedIdResponse
+	34, // 61: uuid.v1.UuidService.GetSegmentId:output_type -> uuid.v1.GetSegmentIdResponse
+	36, // 62: uuid.v1.UuidService.GetSequence:output_type -> uuid.v1.GetSequenceResponse
+	38, // 63: uuid.v1.UuidService.LeaseNodeId:output_type -> uuid.v1.LeaseNodeIdResponse
+	40, // 64: uuid.v1.UuidService.RenewNodeLease:output_type -> uuid.v1.RenewNodeLeaseResponse
+	42, // 65: uuid.v1.UuidService.ReleaseNodeLease:output_type -> uuid.v1.ReleaseNodeLeaseResponse
+	44, // 66: uuid.v1.UuidService.ListNodeLeases:output_type -> uuid.v1.ListNodeLeasesResponse
+	45, // [45:67] is the sub-list for method output_type
+	23, // [23:45] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
+}
+
+func init() { file_uuid_v1_uuid_proto_init() }
+func file_uuid_v1_uuid_proto_init() {
+	if File_uuid_v1_uuid_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_uuid_v1_uuid_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUuidBySnowflakeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUuidBySnowflakeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUuidBySnowflakeBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUuidBySnowflakeBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamUuidBySnowflakeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamUuidBySnowflakeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParseSnowflakeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParseSnowflakeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSnowflakeRangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSnowflakeRangeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUuidByGoogleUUIDV4Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUuidByGoogleUUIDV4Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUuidV7Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUuidV7Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUlidRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUlidResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetKsuidRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetKsuidResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUuidByNameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUuidByNameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUuidByNameBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUuidByNameBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPublicIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPublicIdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatePublicIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatePublicIdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParsePublicIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParsePublicIdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncodeObfuscatedIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncodeObfuscatedIdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodeObfuscatedIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodeObfuscatedIdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSegmentIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSegmentIdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSequenceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSequenceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseNodeIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseNodeIdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenewNodeLeaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
 				return &v.sizeCache
 			case 2:
 				return &v.unknownFields
@@ -459,8 +5565,8 @@ func file_uuid_v1_uuid_proto_init() {
 				return nil
 			}
 		}
-		file_uuid_v1_uuid_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUuidByGoogleUUIDV4Response); i {
+		file_uuid_v1_uuid_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenewNodeLeaseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -471,7 +5577,67 @@ func file_uuid_v1_uuid_proto_init() {
 				return nil
 			}
 		}
-		file_uuid_v1_uuid_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_uuid_v1_uuid_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseNodeLeaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseNodeLeaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListNodeLeasesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListNodeLeasesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrorDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetUuidBySnowflakeResponse_Data); i {
 			case 0:
 				return &v.state
@@ -483,7 +5649,55 @@ func file_uuid_v1_uuid_proto_init() {
 				return nil
 			}
 		}
-		file_uuid_v1_uuid_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_uuid_v1_uuid_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUuidBySnowflakeBatchResponse_Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamUuidBySnowflakeResponse_Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParseSnowflakeResponse_Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSnowflakeRangeResponse_Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetUuidByGoogleUUIDV4Response_Data); i {
 			case 0:
 				return &v.state
@@ -495,19 +5709,212 @@ func file_uuid_v1_uuid_proto_init() {
 				return nil
 			}
 		}
+		file_uuid_v1_uuid_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUuidV7Response_Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUlidResponse_Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetKsuidResponse_Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUuidByNameResponse_Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUuidByNameBatchResponse_Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPublicIdResponse_Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatePublicIdResponse_Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParsePublicIdResponse_Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncodeObfuscatedIdResponse_Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodeObfuscatedIdResponse_Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSegmentIdResponse_Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSequenceResponse_Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseNodeIdResponse_Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenewNodeLeaseResponse_Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListNodeLeasesResponse_Lease); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uuid_v1_uuid_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListNodeLeasesResponse_Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_uuid_v1_uuid_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      1,
+			NumMessages:   67,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_uuid_v1_uuid_proto_goTypes,
 		DependencyIndexes: file_uuid_v1_uuid_proto_depIdxs,
+		EnumInfos:         file_uuid_v1_uuid_proto_enumTypes,
 		MessageInfos:      file_uuid_v1_uuid_proto_msgTypes,
 	}.Build()
 	File_uuid_v1_uuid_proto = out.File
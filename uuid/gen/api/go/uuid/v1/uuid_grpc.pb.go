@@ -20,8 +20,51 @@ const _ = grpc.SupportPackageIsVersion7
 type UuidServiceClient interface {
 	// Get a uuid through the snowflake algorithm
 	GetUuidBySnowflake(ctx context.Context, in *GetUuidBySnowflakeRequest, opts ...grpc.CallOption) (*GetUuidBySnowflakeResponse, error)
+	// Get a batch of uuids through the snowflake algorithm
+	GetUuidBySnowflakeBatch(ctx context.Context, in *GetUuidBySnowflakeBatchRequest, opts ...grpc.CallOption) (*GetUuidBySnowflakeBatchResponse, error)
+	// Keep streaming chunks of snowflake uuids until the client cancels or closes its side,
+	// a chunk is only generated while the client has granted credit for it
+	StreamUuidBySnowflake(ctx context.Context, opts ...grpc.CallOption) (UuidService_StreamUuidBySnowflakeClient, error)
+	// Decode when and on which node a snowflake uuid was generated
+	ParseSnowflake(ctx context.Context, in *ParseSnowflakeRequest, opts ...grpc.CallOption) (*ParseSnowflakeResponse, error)
+	// Get the smallest and largest snowflake uuid that can be generated in a time window
+	GetSnowflakeRange(ctx context.Context, in *GetSnowflakeRangeRequest, opts ...grpc.CallOption) (*GetSnowflakeRangeResponse, error)
 	// Get a uuid through the google uuid v4
 	GetUuidByGoogleUUIDV4(ctx context.Context, in *GetUuidByGoogleUUIDV4Request, opts ...grpc.CallOption) (*GetUuidByGoogleUUIDV4Response, error)
+	// Get the name-based uuid v3 or v5 of a name in a namespace, the same name always maps to the same uuid
+	GetUuidByName(ctx context.Context, in *GetUuidByNameRequest, opts ...grpc.CallOption) (*GetUuidByNameResponse, error)
+	// Get the name-based uuids v3 or v5 of many names in a namespace
+	GetUuidByNameBatch(ctx context.Context, in *GetUuidByNameBatchRequest, opts ...grpc.CallOption) (*GetUuidByNameBatchResponse, error)
+	// Get a time-ordered uuid v7
+	GetUuidV7(ctx context.Context, in *GetUuidV7Request, opts ...grpc.CallOption) (*GetUuidV7Response, error)
+	// Get a time-ordered ulid
+	GetUlid(ctx context.Context, in *GetUlidRequest, opts ...grpc.CallOption) (*GetUlidResponse, error)
+	// Get a time-ordered ksuid
+	GetKsuid(ctx context.Context, in *GetKsuidRequest, opts ...grpc.CallOption) (*GetKsuidResponse, error)
+	// Get a typed public id like ord_2ClJYTlb1eaL of a registered prefix
+	GetPublicId(ctx context.Context, in *GetPublicIdRequest, opts ...grpc.CallOption) (*GetPublicIdResponse, error)
+	// Check the prefix and the check character of a public id
+	ValidatePublicId(ctx context.Context, in *ValidatePublicIdRequest, opts ...grpc.CallOption) (*ValidatePublicIdResponse, error)
+	// Decode the uuid a public id carries
+	ParsePublicId(ctx context.Context, in *ParsePublicIdRequest, opts ...grpc.CallOption) (*ParsePublicIdResponse, error)
+	// Obfuscate a snowflake uuid with the current key, so public apis don't leak how fast the ids grow
+	EncodeObfuscatedId(ctx context.Context, in *EncodeObfuscatedIdRequest, opts ...grpc.CallOption) (*EncodeObfuscatedIdResponse, error)
+	// Recover the snowflake uuid of an obfuscated id with the key of its key version
+	DecodeObfuscatedId(ctx context.Context, in *DecodeObfuscatedIdRequest, opts ...grpc.CallOption) (*DecodeObfuscatedIdResponse, error)
+	// Get the next id of a biz tag out of the segments of the database, ids of a biz tag are dense and increasing
+	GetSegmentId(ctx context.Context, in *GetSegmentIdRequest, opts ...grpc.CallOption) (*GetSegmentIdResponse, error)
+	// Get the next number of a redis sequence, numbers of a sequence are strictly increasing and restart with its reset period
+	GetSequence(ctx context.Context, in *GetSequenceRequest, opts ...grpc.CallOption) (*GetSequenceResponse, error)
+	// Lease a free node id of the shared pool to a client, which generates the snowflake uuids of it in-process
+	// and has to renew the lease before its ttl is over
+	LeaseNodeId(ctx context.Context, in *LeaseNodeIdRequest, opts ...grpc.CallOption) (*LeaseNodeIdResponse, error)
+	// Renew the lease of a node id leased to a client
+	RenewNodeLease(ctx context.Context, in *RenewNodeLeaseRequest, opts ...grpc.CallOption) (*RenewNodeLeaseResponse, error)
+	// Give a node id leased to a client back to the pool
+	ReleaseNodeLease(ctx context.Context, in *ReleaseNodeLeaseRequest, opts ...grpc.CallOption) (*ReleaseNodeLeaseResponse, error)
+	// List the live leases of the node id pool, the ones of the servers and of the clients,
+	// it is grpc only like the other rpcs of the leases as it shows the owners
+	ListNodeLeases(ctx context.Context, in *ListNodeLeasesRequest, opts ...grpc.CallOption) (*ListNodeLeasesResponse, error)
 }
 
 type uuidServiceClient struct {
@@ -41,6 +84,64 @@ func (c *uuidServiceClient) GetUuidBySnowflake(ctx context.Context, in *GetUuidB
 	return out, nil
 }
 
+func (c *uuidServiceClient) GetUuidBySnowflakeBatch(ctx context.Context, in *GetUuidBySnowflakeBatchRequest, opts ...grpc.CallOption) (*GetUuidBySnowflakeBatchResponse, error) {
+	out := new(GetUuidBySnowflakeBatchResponse)
+	err := c.cc.Invoke(ctx, "/uuid.v1.UuidService/GetUuidBySnowflakeBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uuidServiceClient) StreamUuidBySnowflake(ctx context.Context, opts ...grpc.CallOption) (UuidService_StreamUuidBySnowflakeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &UuidService_ServiceDesc.Streams[0], "/uuid.v1.UuidService/StreamUuidBySnowflake", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &uuidServiceStreamUuidBySnowflakeClient{stream}
+	return x, nil
+}
+
+type UuidService_StreamUuidBySnowflakeClient interface {
+	Send(*StreamUuidBySnowflakeRequest) error
+	Recv() (*StreamUuidBySnowflakeResponse, error)
+	grpc.ClientStream
+}
+
+type uuidServiceStreamUuidBySnowflakeClient struct {
+	grpc.ClientStream
+}
+
+func (x *uuidServiceStreamUuidBySnowflakeClient) Send(m *StreamUuidBySnowflakeRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *uuidServiceStreamUuidBySnowflakeClient) Recv() (*StreamUuidBySnowflakeResponse, error) {
+	m := new(StreamUuidBySnowflakeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *uuidServiceClient) ParseSnowflake(ctx context.Context, in *ParseSnowflakeRequest, opts ...grpc.CallOption) (*ParseSnowflakeResponse, error) {
+	out := new(ParseSnowflakeResponse)
+	err := c.cc.Invoke(ctx, "/uuid.v1.UuidService/ParseSnowflake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uuidServiceClient) GetSnowflakeRange(ctx context.Context, in *GetSnowflakeRangeRequest, opts ...grpc.CallOption) (*GetSnowflakeRangeResponse, error) {
+	out := new(GetSnowflakeRangeResponse)
+	err := c.cc.Invoke(ctx, "/uuid.v1.UuidService/GetSnowflakeRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *uuidServiceClient) GetUuidByGoogleUUIDV4(ctx context.Context, in *GetUuidByGoogleUUIDV4Request, opts ...grpc.CallOption) (*GetUuidByGoogleUUIDV4Response, error) {
 	out := new(GetUuidByGoogleUUIDV4Response)
 	err := c.cc.Invoke(ctx, "/uuid.v1.UuidService/GetUuidByGoogleUUIDV4", in, out, opts...)
@@ -50,14 +151,201 @@ func (c *uuidServiceClient) GetUuidByGoogleUUIDV4(ctx context.Context, in *GetUu
 	return out, nil
 }
 
+func (c *uuidServiceClient) GetUuidByName(ctx context.Context, in *GetUuidByNameRequest, opts ...grpc.CallOption) (*GetUuidByNameResponse, error) {
+	out := new(GetUuidByNameResponse)
+	err := c.cc.Invoke(ctx, "/uuid.v1.UuidService/GetUuidByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uuidServiceClient) GetUuidByNameBatch(ctx context.Context, in *GetUuidByNameBatchRequest, opts ...grpc.CallOption) (*GetUuidByNameBatchResponse, error) {
+	out := new(GetUuidByNameBatchResponse)
+	err := c.cc.Invoke(ctx, "/uuid.v1.UuidService/GetUuidByNameBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uuidServiceClient) GetUuidV7(ctx context.Context, in *GetUuidV7Request, opts ...grpc.CallOption) (*GetUuidV7Response, error) {
+	out := new(GetUuidV7Response)
+	err := c.cc.Invoke(ctx, "/uuid.v1.UuidService/GetUuidV7", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uuidServiceClient) GetUlid(ctx context.Context, in *GetUlidRequest, opts ...grpc.CallOption) (*GetUlidResponse, error) {
+	out := new(GetUlidResponse)
+	err := c.cc.Invoke(ctx, "/uuid.v1.UuidService/GetUlid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uuidServiceClient) GetKsuid(ctx context.Context, in *GetKsuidRequest, opts ...grpc.CallOption) (*GetKsuidResponse, error) {
+	out := new(GetKsuidResponse)
+	err := c.cc.Invoke(ctx, "/uuid.v1.UuidService/GetKsuid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uuidServiceClient) GetPublicId(ctx context.Context, in *GetPublicIdRequest, opts ...grpc.CallOption) (*GetPublicIdResponse, error) {
+	out := new(GetPublicIdResponse)
+	err := c.cc.Invoke(ctx, "/uuid.v1.UuidService/GetPublicId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uuidServiceClient) ValidatePublicId(ctx context.Context, in *ValidatePublicIdRequest, opts ...grpc.CallOption) (*ValidatePublicIdResponse, error) {
+	out := new(ValidatePublicIdResponse)
+	err := c.cc.Invoke(ctx, "/uuid.v1.UuidService/ValidatePublicId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uuidServiceClient) ParsePublicId(ctx context.Context, in *ParsePublicIdRequest, opts ...grpc.CallOption) (*ParsePublicIdResponse, error) {
+	out := new(ParsePublicIdResponse)
+	err := c.cc.Invoke(ctx, "/uuid.v1.UuidService/ParsePublicId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uuidServiceClient) EncodeObfuscatedId(ctx context.Context, in *EncodeObfuscatedIdRequest, opts ...grpc.CallOption) (*EncodeObfuscatedIdResponse, error) {
+	out := new(EncodeObfuscatedIdResponse)
+	err := c.cc.Invoke(ctx, "/uuid.v1.UuidService/EncodeObfuscatedId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uuidServiceClient) DecodeObfuscatedId(ctx context.Context, in *DecodeObfuscatedIdRequest, opts ...grpc.CallOption) (*DecodeObfuscatedIdResponse, error) {
+	out := new(DecodeObfuscatedIdResponse)
+	err := c.cc.Invoke(ctx, "/uuid.v1.UuidService/DecodeObfuscatedId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uuidServiceClient) GetSegmentId(ctx context.Context, in *GetSegmentIdRequest, opts ...grpc.CallOption) (*GetSegmentIdResponse, error) {
+	out := new(GetSegmentIdResponse)
+	err := c.cc.Invoke(ctx, "/uuid.v1.UuidService/GetSegmentId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uuidServiceClient) GetSequence(ctx context.Context, in *GetSequenceRequest, opts ...grpc.CallOption) (*GetSequenceResponse, error) {
+	out := new(GetSequenceResponse)
+	err := c.cc.Invoke(ctx, "/uuid.v1.UuidService/GetSequence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uuidServiceClient) LeaseNodeId(ctx context.Context, in *LeaseNodeIdRequest, opts ...grpc.CallOption) (*LeaseNodeIdResponse, error) {
+	out := new(LeaseNodeIdResponse)
+	err := c.cc.Invoke(ctx, "/uuid.v1.UuidService/LeaseNodeId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uuidServiceClient) RenewNodeLease(ctx context.Context, in *RenewNodeLeaseRequest, opts ...grpc.CallOption) (*RenewNodeLeaseResponse, error) {
+	out := new(RenewNodeLeaseResponse)
+	err := c.cc.Invoke(ctx, "/uuid.v1.UuidService/RenewNodeLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uuidServiceClient) ReleaseNodeLease(ctx context.Context, in *ReleaseNodeLeaseRequest, opts ...grpc.CallOption) (*ReleaseNodeLeaseResponse, error) {
+	out := new(ReleaseNodeLeaseResponse)
+	err := c.cc.Invoke(ctx, "/uuid.v1.UuidService/ReleaseNodeLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uuidServiceClient) ListNodeLeases(ctx context.Context, in *ListNodeLeasesRequest, opts ...grpc.CallOption) (*ListNodeLeasesResponse, error) {
+	out := new(ListNodeLeasesResponse)
+	err := c.cc.Invoke(ctx, "/uuid.v1.UuidService/ListNodeLeases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UuidServiceServer is the server API for UuidService service.
 // All implementations should embed UnimplementedUuidServiceServer
 // for forward compatibility
 type UuidServiceServer interface {
 	// Get a uuid through the snowflake algorithm
 	GetUuidBySnowflake(context.Context, *GetUuidBySnowflakeRequest) (*GetUuidBySnowflakeResponse, error)
+	// Get a batch of uuids through the snowflake algorithm
+	GetUuidBySnowflakeBatch(context.Context, *GetUuidBySnowflakeBatchRequest) (*GetUuidBySnowflakeBatchResponse, error)
+	// Keep streaming chunks of snowflake uuids until the client cancels or closes its side,
+	// a chunk is only generated while the client has granted credit for it
+	StreamUuidBySnowflake(UuidService_StreamUuidBySnowflakeServer) error
+	// Decode when and on which node a snowflake uuid was generated
+	ParseSnowflake(context.Context, *ParseSnowflakeRequest) (*ParseSnowflakeResponse, error)
+	// Get the smallest and largest snowflake uuid that can be generated in a time window
+	GetSnowflakeRange(context.Context, *GetSnowflakeRangeRequest) (*GetSnowflakeRangeResponse, error)
 	// Get a uuid through the google uuid v4
 	GetUuidByGoogleUUIDV4(context.Context, *GetUuidByGoogleUUIDV4Request) (*GetUuidByGoogleUUIDV4Response, error)
+	// Get the name-based uuid v3 or v5 of a name in a namespace, the same name always maps to the same uuid
+	GetUuidByName(context.Context, *GetUuidByNameRequest) (*GetUuidByNameResponse, error)
+	// Get the name-based uuids v3 or v5 of many names in a namespace
+	GetUuidByNameBatch(context.Context, *GetUuidByNameBatchRequest) (*GetUuidByNameBatchResponse, error)
+	// Get a time-ordered uuid v7
+	GetUuidV7(context.Context, *GetUuidV7Request) (*GetUuidV7Response, error)
+	// Get a time-ordered ulid
+	GetUlid(context.Context, *GetUlidRequest) (*GetUlidResponse, error)
+	// Get a time-ordered ksuid
+	GetKsuid(context.Context, *GetKsuidRequest) (*GetKsuidResponse, error)
+	// Get a typed public id like ord_2ClJYTlb1eaL of a registered prefix
+	GetPublicId(context.Context, *GetPublicIdRequest) (*GetPublicIdResponse, error)
+	// Check the prefix and the check character of a public id
+	ValidatePublicId(context.Context, *ValidatePublicIdRequest) (*ValidatePublicIdResponse, error)
+	// Decode the uuid a public id carries
+	ParsePublicId(context.Context, *ParsePublicIdRequest) (*ParsePublicIdResponse, error)
+	// Obfuscate a snowflake uuid with the current key, so public apis don't leak how fast the ids grow
+	EncodeObfuscatedId(context.Context, *EncodeObfuscatedIdRequest) (*EncodeObfuscatedIdResponse, error)
+	// Recover the snowflake uuid of an obfuscated id with the key of its key version
+	DecodeObfuscatedId(context.Context, *DecodeObfuscatedIdRequest) (*DecodeObfuscatedIdResponse, error)
+	// Get the next id of a biz tag out of the segments of the database, ids of a biz tag are dense and increasing
+	GetSegmentId(context.Context, *GetSegmentIdRequest) (*GetSegmentIdResponse, error)
+	// Get the next number of a redis sequence, numbers of a sequence are strictly increasing and restart with its reset period
+	GetSequence(context.Context, *GetSequenceRequest) (*GetSequenceResponse, error)
+	// Lease a free node id of the shared pool to a client, which generates the snowflake uuids of it in-process
+	// and has to renew the lease before its ttl is over
+	LeaseNodeId(context.Context, *LeaseNodeIdRequest) (*LeaseNodeIdResponse, error)
+	// Renew the lease of a node id leased to a client
+	RenewNodeLease(context.Context, *RenewNodeLeaseRequest) (*RenewNodeLeaseResponse, error)
+	// Give a node id leased to a client back to the pool
+	ReleaseNodeLease(context.Context, *ReleaseNodeLeaseRequest) (*ReleaseNodeLeaseResponse, error)
+	// List the live leases of the node id pool, the ones of the servers and of the clients,
+	// it is grpc only like the other rpcs of the leases as it shows the owners
+	ListNodeLeases(context.Context, *ListNodeLeasesRequest) (*ListNodeLeasesResponse, error)
 }
 
 // UnimplementedUuidServiceServer should be embedded to have forward compatible implementations.
@@ -67,9 +355,69 @@ type UnimplementedUuidServiceServer struct {
 func (UnimplementedUuidServiceServer) GetUuidBySnowflake(context.Context, *GetUuidBySnowflakeRequest) (*GetUuidBySnowflakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUuidBySnowflake not implemented")
 }
+func (UnimplementedUuidServiceServer) GetUuidBySnowflakeBatch(context.Context, *GetUuidBySnowflakeBatchRequest) (*GetUuidBySnowflakeBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUuidBySnowflakeBatch not implemented")
+}
+func (UnimplementedUuidServiceServer) StreamUuidBySnowflake(UuidService_StreamUuidBySnowflakeServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamUuidBySnowflake not implemented")
+}
+func (UnimplementedUuidServiceServer) ParseSnowflake(context.Context, *ParseSnowflakeRequest) (*ParseSnowflakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParseSnowflake not implemented")
+}
+func (UnimplementedUuidServiceServer) GetSnowflakeRange(context.Context, *GetSnowflakeRangeRequest) (*GetSnowflakeRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSnowflakeRange not implemented")
+}
 func (UnimplementedUuidServiceServer) GetUuidByGoogleUUIDV4(context.Context, *GetUuidByGoogleUUIDV4Request) (*GetUuidByGoogleUUIDV4Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUuidByGoogleUUIDV4 not implemented")
 }
+func (UnimplementedUuidServiceServer) GetUuidByName(context.Context, *GetUuidByNameRequest) (*GetUuidByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUuidByName not implemented")
+}
+func (UnimplementedUuidServiceServer) GetUuidByNameBatch(context.Context, *GetUuidByNameBatchRequest) (*GetUuidByNameBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUuidByNameBatch not implemented")
+}
+func (UnimplementedUuidServiceServer) GetUuidV7(context.Context, *GetUuidV7Request) (*GetUuidV7Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUuidV7 not implemented")
+}
+func (UnimplementedUuidServiceServer) GetUlid(context.Context, *GetUlidRequest) (*GetUlidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUlid not implemented")
+}
+func (UnimplementedUuidServiceServer) GetKsuid(context.Context, *GetKsuidRequest) (*GetKsuidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKsuid not implemented")
+}
+func (UnimplementedUuidServiceServer) GetPublicId(context.Context, *GetPublicIdRequest) (*GetPublicIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicId not implemented")
+}
+func (UnimplementedUuidServiceServer) ValidatePublicId(context.Context, *ValidatePublicIdRequest) (*ValidatePublicIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatePublicId not implemented")
+}
+func (UnimplementedUuidServiceServer) ParsePublicId(context.Context, *ParsePublicIdRequest) (*ParsePublicIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParsePublicId not implemented")
+}
+func (UnimplementedUuidServiceServer) EncodeObfuscatedId(context.Context, *EncodeObfuscatedIdRequest) (*EncodeObfuscatedIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncodeObfuscatedId not implemented")
+}
+func (UnimplementedUuidServiceServer) DecodeObfuscatedId(context.Context, *DecodeObfuscatedIdRequest) (*DecodeObfuscatedIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeObfuscatedId not implemented")
+}
+func (UnimplementedUuidServiceServer) GetSegmentId(context.Context, *GetSegmentIdRequest) (*GetSegmentIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentId not implemented")
+}
+func (UnimplementedUuidServiceServer) GetSequence(context.Context, *GetSequenceRequest) (*GetSequenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSequence not implemented")
+}
+func (UnimplementedUuidServiceServer) LeaseNodeId(context.Context, *LeaseNodeIdRequest) (*LeaseNodeIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaseNodeId not implemented")
+}
+func (UnimplementedUuidServiceServer) RenewNodeLease(context.Context, *RenewNodeLeaseRequest) (*RenewNodeLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewNodeLease not implemented")
+}
+func (UnimplementedUuidServiceServer) ReleaseNodeLease(context.Context, *ReleaseNodeLeaseRequest) (*ReleaseNodeLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseNodeLease not implemented")
+}
+func (UnimplementedUuidServiceServer) ListNodeLeases(context.Context, *ListNodeLeasesRequest) (*ListNodeLeasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNodeLeases not implemented")
+}
 
 // UnsafeUuidServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to UuidServiceServer will
@@ -100,6 +448,86 @@ func _UuidService_GetUuidBySnowflake_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UuidService_GetUuidBySnowflakeBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUuidBySnowflakeBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UuidServiceServer).GetUuidBySnowflakeBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uuid.v1.UuidService/GetUuidBySnowflakeBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UuidServiceServer).GetUuidBySnowflakeBatch(ctx, req.(*GetUuidBySnowflakeBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UuidService_StreamUuidBySnowflake_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(UuidServiceServer).StreamUuidBySnowflake(&uuidServiceStreamUuidBySnowflakeServer{stream})
+}
+
+type UuidService_StreamUuidBySnowflakeServer interface {
+	Send(*StreamUuidBySnowflakeResponse) error
+	Recv() (*StreamUuidBySnowflakeRequest, error)
+	grpc.ServerStream
+}
+
+type uuidServiceStreamUuidBySnowflakeServer struct {
+	grpc.ServerStream
+}
+
+func (x *uuidServiceStreamUuidBySnowflakeServer) Send(m *StreamUuidBySnowflakeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *uuidServiceStreamUuidBySnowflakeServer) Recv() (*StreamUuidBySnowflakeRequest, error) {
+	m := new(StreamUuidBySnowflakeRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _UuidService_ParseSnowflake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseSnowflakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UuidServiceServer).ParseSnowflake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uuid.v1.UuidService/ParseSnowflake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UuidServiceServer).ParseSnowflake(ctx, req.(*ParseSnowflakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UuidService_GetSnowflakeRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSnowflakeRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UuidServiceServer).GetSnowflakeRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uuid.v1.UuidService/GetSnowflakeRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UuidServiceServer).GetSnowflakeRange(ctx, req.(*GetSnowflakeRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UuidService_GetUuidByGoogleUUIDV4_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUuidByGoogleUUIDV4Request)
 	if err := dec(in); err != nil {
@@ -118,6 +546,294 @@ func _UuidService_GetUuidByGoogleUUIDV4_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UuidService_GetUuidByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUuidByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UuidServiceServer).GetUuidByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uuid.v1.UuidService/GetUuidByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UuidServiceServer).GetUuidByName(ctx, req.(*GetUuidByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UuidService_GetUuidByNameBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUuidByNameBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UuidServiceServer).GetUuidByNameBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uuid.v1.UuidService/GetUuidByNameBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UuidServiceServer).GetUuidByNameBatch(ctx, req.(*GetUuidByNameBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UuidService_GetUuidV7_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUuidV7Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UuidServiceServer).GetUuidV7(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uuid.v1.UuidService/GetUuidV7",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UuidServiceServer).GetUuidV7(ctx, req.(*GetUuidV7Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UuidService_GetUlid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUlidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UuidServiceServer).GetUlid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uuid.v1.UuidService/GetUlid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UuidServiceServer).GetUlid(ctx, req.(*GetUlidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UuidService_GetKsuid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKsuidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UuidServiceServer).GetKsuid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uuid.v1.UuidService/GetKsuid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UuidServiceServer).GetKsuid(ctx, req.(*GetKsuidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UuidService_GetPublicId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UuidServiceServer).GetPublicId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uuid.v1.UuidService/GetPublicId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UuidServiceServer).GetPublicId(ctx, req.(*GetPublicIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UuidService_ValidatePublicId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatePublicIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UuidServiceServer).ValidatePublicId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uuid.v1.UuidService/ValidatePublicId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UuidServiceServer).ValidatePublicId(ctx, req.(*ValidatePublicIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UuidService_ParsePublicId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParsePublicIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UuidServiceServer).ParsePublicId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uuid.v1.UuidService/ParsePublicId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UuidServiceServer).ParsePublicId(ctx, req.(*ParsePublicIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UuidService_EncodeObfuscatedId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncodeObfuscatedIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UuidServiceServer).EncodeObfuscatedId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uuid.v1.UuidService/EncodeObfuscatedId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UuidServiceServer).EncodeObfuscatedId(ctx, req.(*EncodeObfuscatedIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UuidService_DecodeObfuscatedId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeObfuscatedIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UuidServiceServer).DecodeObfuscatedId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uuid.v1.UuidService/DecodeObfuscatedId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UuidServiceServer).DecodeObfuscatedId(ctx, req.(*DecodeObfuscatedIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UuidService_GetSegmentId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UuidServiceServer).GetSegmentId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uuid.v1.UuidService/GetSegmentId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UuidServiceServer).GetSegmentId(ctx, req.(*GetSegmentIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UuidService_GetSequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSequenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UuidServiceServer).GetSequence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uuid.v1.UuidService/GetSequence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UuidServiceServer).GetSequence(ctx, req.(*GetSequenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UuidService_LeaseNodeId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseNodeIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UuidServiceServer).LeaseNodeId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uuid.v1.UuidService/LeaseNodeId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UuidServiceServer).LeaseNodeId(ctx, req.(*LeaseNodeIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UuidService_RenewNodeLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewNodeLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UuidServiceServer).RenewNodeLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uuid.v1.UuidService/RenewNodeLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UuidServiceServer).RenewNodeLease(ctx, req.(*RenewNodeLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UuidService_ReleaseNodeLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseNodeLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UuidServiceServer).ReleaseNodeLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uuid.v1.UuidService/ReleaseNodeLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UuidServiceServer).ReleaseNodeLease(ctx, req.(*ReleaseNodeLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UuidService_ListNodeLeases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNodeLeasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UuidServiceServer).ListNodeLeases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/uuid.v1.UuidService/ListNodeLeases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UuidServiceServer).ListNodeLeases(ctx, req.(*ListNodeLeasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UuidService_ServiceDesc is the grpc.ServiceDesc for UuidService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -129,11 +845,94 @@ var UuidService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUuidBySnowflake",
 			Handler:    _UuidService_GetUuidBySnowflake_Handler,
 		},
+		{
+			MethodName: "GetUuidBySnowflakeBatch",
+			Handler:    _UuidService_GetUuidBySnowflakeBatch_Handler,
+		},
+		{
+			MethodName: "ParseSnowflake",
+			Handler:    _UuidService_ParseSnowflake_Handler,
+		},
+		{
+			MethodName: "GetSnowflakeRange",
+			Handler:    _UuidService_GetSnowflakeRange_Handler,
+		},
 		{
 			MethodName: "GetUuidByGoogleUUIDV4",
 			Handler:    _UuidService_GetUuidByGoogleUUIDV4_Handler,
 		},
+		{
+			MethodName: "GetUuidByName",
+			Handler:    _UuidService_GetUuidByName_Handler,
+		},
+		{
+			MethodName: "GetUuidByNameBatch",
+			Handler:    _UuidService_GetUuidByNameBatch_Handler,
+		},
+		{
+			MethodName: "GetUuidV7",
+			Handler:    _UuidService_GetUuidV7_Handler,
+		},
+		{
+			MethodName: "GetUlid",
+			Handler:    _UuidService_GetUlid_Handler,
+		},
+		{
+			MethodName: "GetKsuid",
+			Handler:    _UuidService_GetKsuid_Handler,
+		},
+		{
+			MethodName: "GetPublicId",
+			Handler:    _UuidService_GetPublicId_Handler,
+		},
+		{
+			MethodName: "ValidatePublicId",
+			Handler:    _UuidService_ValidatePublicId_Handler,
+		},
+		{
+			MethodName: "ParsePublicId",
+			Handler:    _UuidService_ParsePublicId_Handler,
+		},
+		{
+			MethodName: "EncodeObfuscatedId",
+			Handler:    _UuidService_EncodeObfuscatedId_Handler,
+		},
+		{
+			MethodName: "DecodeObfuscatedId",
+			Handler:    _UuidService_DecodeObfuscatedId_Handler,
+		},
+		{
+			MethodName: "GetSegmentId",
+			Handler:    _UuidService_GetSegmentId_Handler,
+		},
+		{
+			MethodName: "GetSequence",
+			Handler:    _UuidService_GetSequence_Handler,
+		},
+		{
+			MethodName: "LeaseNodeId",
+			Handler:    _UuidService_LeaseNodeId_Handler,
+		},
+		{
+			MethodName: "RenewNodeLease",
+			Handler:    _UuidService_RenewNodeLease_Handler,
+		},
+		{
+			MethodName: "ReleaseNodeLease",
+			Handler:    _UuidService_ReleaseNodeLease_Handler,
+		},
+		{
+			MethodName: "ListNodeLeases",
+			Handler:    _UuidService_ListNodeLeases_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamUuidBySnowflake",
+			Handler:       _UuidService_StreamUuidBySnowflake_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "uuid/v1/uuid.proto",
 }
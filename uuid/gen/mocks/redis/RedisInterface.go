@@ -2,27 +2,35 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	redis "github.com/douyu/jupiter-examples/uuid/internal/pkg/redis"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
 
 // RedisInterface is an autogenerated mock type for the RedisInterface type
 type RedisInterface struct {
 	mock.Mock
 }
 
-// GetNodeId provides a mock function with given fields:
-func (_m *RedisInterface) GetNodeId() (int64, error) {
-	ret := _m.Called()
+// AcquireNodeId provides a mock function with given fields: ctx, maxNodeId, owner, ttl
+func (_m *RedisInterface) AcquireNodeId(ctx context.Context, maxNodeId int64, owner string, ttl time.Duration) (int64, error) {
+	ret := _m.Called(ctx, maxNodeId, owner, ttl)
 
 	var r0 int64
-	if rf, ok := ret.Get(0).(func() int64); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, time.Duration) int64); ok {
+		r0 = rf(ctx, maxNodeId, owner, ttl)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, time.Duration) error); ok {
+		r1 = rf(ctx, maxNodeId, owner, ttl)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -30,6 +38,180 @@ func (_m *RedisInterface) GetNodeId() (int64, error) {
 	return r0, r1
 }
 
+// ClaimIdempotencyKey provides a mock function with given fields: ctx, key, record, ttl
+func (_m *RedisInterface) ClaimIdempotencyKey(ctx context.Context, key string, record []byte, ttl time.Duration) ([]byte, error) {
+	ret := _m.Called(ctx, key, record, ttl)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte, time.Duration) []byte); ok {
+		r0 = rf(ctx, key, record, ttl)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []byte, time.Duration) error); ok {
+		r1 = rf(ctx, key, record, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHighWaterMark provides a mock function with given fields: ctx, nodeId
+func (_m *RedisInterface) GetHighWaterMark(ctx context.Context, nodeId int64) (int64, error) {
+	ret := _m.Called(ctx, nodeId)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = rf(ctx, nodeId)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, nodeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetIdempotencyKey provides a mock function with given fields: ctx, key
+func (_m *RedisInterface) GetIdempotencyKey(ctx context.Context, key string) ([]byte, error) {
+	ret := _m.Called(ctx, key)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(context.Context, string) []byte); ok {
+		r0 = rf(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListNodeLeases provides a mock function with given fields: ctx, maxNodeId
+func (_m *RedisInterface) ListNodeLeases(ctx context.Context, maxNodeId int64) ([]redis.NodeLease, error) {
+	ret := _m.Called(ctx, maxNodeId)
+
+	var r0 []redis.NodeLease
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []redis.NodeLease); ok {
+		r0 = rf(ctx, maxNodeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]redis.NodeLease)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, maxNodeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MaxMemoryPolicy provides a mock function with given fields: ctx
+func (_m *RedisInterface) MaxMemoryPolicy(ctx context.Context) (string, error) {
+	ret := _m.Called(ctx)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReleaseNodeId provides a mock function with given fields: ctx, nodeId, owner
+func (_m *RedisInterface) ReleaseNodeId(ctx context.Context, nodeId int64, owner string) error {
+	ret := _m.Called(ctx, nodeId, owner)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, nodeId, owner)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RenewNodeId provides a mock function with given fields: ctx, nodeId, owner, ttl
+func (_m *RedisInterface) RenewNodeId(ctx context.Context, nodeId int64, owner string, ttl time.Duration) error {
+	ret := _m.Called(ctx, nodeId, owner, ttl)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, time.Duration) error); ok {
+		r0 = rf(ctx, nodeId, owner, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReserveSequence provides a mock function with given fields: ctx, key, count, start, ttl
+func (_m *RedisInterface) ReserveSequence(ctx context.Context, key string, count int64, start int64, ttl time.Duration) (int64, error) {
+	ret := _m.Called(ctx, key, count, start, ttl)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64, time.Duration) int64); ok {
+		r0 = rf(ctx, key, count, start, ttl)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, int64, time.Duration) error); ok {
+		r1 = rf(ctx, key, count, start, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetHighWaterMark provides a mock function with given fields: ctx, nodeId, mark
+func (_m *RedisInterface) SetHighWaterMark(ctx context.Context, nodeId int64, mark int64) error {
+	ret := _m.Called(ctx, nodeId, mark)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, nodeId, mark)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewRedisInterface interface {
 	mock.TestingT
 	Cleanup(func())
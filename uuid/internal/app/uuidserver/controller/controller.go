@@ -4,9 +4,10 @@ import (
 	"github.com/google/wire"
 )
 
-var ProviderSet = wire.NewSet(NewUuidHTTPController, NewUUuidGrpcController)
+var ProviderSet = wire.NewSet(NewUuidHTTPController, NewUUuidGrpcController, NewUuidRespController)
 
 type Options struct {
 	UuidHTTP *UuidHTTP
 	UuidGrpc *UuidGrpc
+	UuidResp *UuidResp
 }
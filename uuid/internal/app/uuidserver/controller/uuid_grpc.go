@@ -8,6 +8,8 @@ import (
 	"github.com/douyu/jupiter/pkg/util/xerror"
 	"github.com/douyu/jupiter/pkg/xlog"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 type UuidGrpc struct {
@@ -21,13 +23,59 @@ func NewUUuidGrpcController(uuid *service.Uuid) *UuidGrpc {
 }
 
 func (u *UuidGrpc) GetUuidBySnowflake(ctx context.Context, req *uuidv1.GetUuidBySnowflakeRequest) (*uuidv1.GetUuidBySnowflakeResponse, error) {
+	if req.GetIdempotencyKey() == "" {
+		req.IdempotencyKey = idempotencyKey(ctx)
+	}
+
 	res, err := u.uuid.GetUuidBySnowflake(ctx, req)
 	if err != nil {
 		xlog.Error("getUuidBySnowflake failed", zap.Error(err), zap.Any("res", res), zap.Any("req", req))
-		return &uuidv1.GetUuidBySnowflakeResponse{
-			Error: uint32(xerror.Convert(err).GetEcode()),
-			Msg:   xerror.Convert(err).GetMsg(),
-		}, nil
+		return nil, grpcError(err)
+	}
+
+	return res, nil
+}
+
+func (u *UuidGrpc) GetUuidBySnowflakeBatch(ctx context.Context, req *uuidv1.GetUuidBySnowflakeBatchRequest) (*uuidv1.GetUuidBySnowflakeBatchResponse, error) {
+	if req.GetIdempotencyKey() == "" {
+		req.IdempotencyKey = idempotencyKey(ctx)
+	}
+
+	res, err := u.uuid.GetUuidBySnowflakeBatch(ctx, req)
+	if err != nil {
+		xlog.Error("getUuidBySnowflakeBatch failed", zap.Error(err), zap.Any("res", res), zap.Any("req", req))
+		return nil, grpcError(err)
+	}
+
+	return res, nil
+}
+
+func (u *UuidGrpc) StreamUuidBySnowflake(stream uuidv1.UuidService_StreamUuidBySnowflakeServer) error {
+	err := u.uuid.StreamUuidBySnowflake(stream.Context(), stream.Recv, stream.Send)
+	if err == nil || stream.Context().Err() != nil {
+		// the consumer went away
+		return nil
+	}
+
+	xlog.Error("streamUuidBySnowflake failed", zap.Error(err))
+	return grpcError(err)
+}
+
+func (u *UuidGrpc) ParseSnowflake(ctx context.Context, req *uuidv1.ParseSnowflakeRequest) (*uuidv1.ParseSnowflakeResponse, error) {
+	res, err := u.uuid.ParseSnowflake(ctx, req)
+	if err != nil {
+		xlog.Error("parseSnowflake failed", zap.Error(err), zap.Any("res", res), zap.Any("req", req))
+		return nil, grpcError(err)
+	}
+
+	return res, nil
+}
+
+func (u *UuidGrpc) GetSnowflakeRange(ctx context.Context, req *uuidv1.GetSnowflakeRangeRequest) (*uuidv1.GetSnowflakeRangeResponse, error) {
+	res, err := u.uuid.GetSnowflakeRange(ctx, req)
+	if err != nil {
+		xlog.Error("getSnowflakeRange failed", zap.Error(err), zap.Any("res", res), zap.Any("req", req))
+		return nil, grpcError(err)
 	}
 
 	return res, nil
@@ -37,11 +85,187 @@ func (u *UuidGrpc) GetUuidByGoogleUUIDV4(ctx context.Context, req *uuidv1.GetUui
 	res, err := u.uuid.GetUuidByGoogleUUIDV4(ctx, req)
 	if err != nil {
 		xlog.Error("getUuidByGoogleUUIDV4 failed", zap.Error(err), zap.Any("res", res), zap.Any("req", req))
-		return &uuidv1.GetUuidByGoogleUUIDV4Response{
-			Error: uint32(xerror.Convert(err).GetEcode()),
-			Msg:   xerror.Convert(err).GetMsg(),
-		}, nil
+		return nil, grpcError(err)
+	}
+
+	return res, nil
+}
+
+func (u *UuidGrpc) GetUuidByName(ctx context.Context, req *uuidv1.GetUuidByNameRequest) (*uuidv1.GetUuidByNameResponse, error) {
+	res, err := u.uuid.GetUuidByName(ctx, req)
+	if err != nil {
+		xlog.Error("getUuidByName failed", zap.Error(err), zap.Any("res", res), zap.Any("req", req))
+		return nil, grpcError(err)
+	}
+
+	return res, nil
+}
+
+func (u *UuidGrpc) GetUuidByNameBatch(ctx context.Context, req *uuidv1.GetUuidByNameBatchRequest) (*uuidv1.GetUuidByNameBatchResponse, error) {
+	res, err := u.uuid.GetUuidByNameBatch(ctx, req)
+	if err != nil {
+		xlog.Error("getUuidByNameBatch failed", zap.Error(err), zap.Any("res", res), zap.Any("req", req))
+		return nil, grpcError(err)
+	}
+
+	return res, nil
+}
+
+func (u *UuidGrpc) GetUuidV7(ctx context.Context, req *uuidv1.GetUuidV7Request) (*uuidv1.GetUuidV7Response, error) {
+	res, err := u.uuid.GetUuidV7(ctx, req)
+	if err != nil {
+		xlog.Error("getUuidV7 failed", zap.Error(err), zap.Any("res", res), zap.Any("req", req))
+		return nil, grpcError(err)
+	}
+
+	return res, nil
+}
+
+func (u *UuidGrpc) GetUlid(ctx context.Context, req *uuidv1.GetUlidRequest) (*uuidv1.GetUlidResponse, error) {
+	res, err := u.uuid.GetUlid(ctx, req)
+	if err != nil {
+		xlog.Error("getUlid failed", zap.Error(err), zap.Any("res", res), zap.Any("req", req))
+		return nil, grpcError(err)
+	}
+
+	return res, nil
+}
+
+func (u *UuidGrpc) GetKsuid(ctx context.Context, req *uuidv1.GetKsuidRequest) (*uuidv1.GetKsuidResponse, error) {
+	res, err := u.uuid.GetKsuid(ctx, req)
+	if err != nil {
+		xlog.Error("getKsuid failed", zap.Error(err), zap.Any("res", res), zap.Any("req", req))
+		return nil, grpcError(err)
+	}
+
+	return res, nil
+}
+
+func (u *UuidGrpc) GetPublicId(ctx context.Context, req *uuidv1.GetPublicIdRequest) (*uuidv1.GetPublicIdResponse, error) {
+	res, err := u.uuid.GetPublicId(ctx, req)
+	if err != nil {
+		xlog.Error("getPublicId failed", zap.Error(err), zap.Any("res", res), zap.Any("req", req))
+		return nil, grpcError(err)
+	}
+
+	return res, nil
+}
+
+func (u *UuidGrpc) ValidatePublicId(ctx context.Context, req *uuidv1.ValidatePublicIdRequest) (*uuidv1.ValidatePublicIdResponse, error) {
+	res, err := u.uuid.ValidatePublicId(ctx, req)
+	if err != nil {
+		xlog.Error("validatePublicId failed", zap.Error(err), zap.Any("res", res), zap.Any("req", req))
+		return nil, grpcError(err)
+	}
+
+	return res, nil
+}
+
+func (u *UuidGrpc) ParsePublicId(ctx context.Context, req *uuidv1.ParsePublicIdRequest) (*uuidv1.ParsePublicIdResponse, error) {
+	res, err := u.uuid.ParsePublicId(ctx, req)
+	if err != nil {
+		xlog.Error("parsePublicId failed", zap.Error(err), zap.Any("res", res), zap.Any("req", req))
+		return nil, grpcError(err)
+	}
+
+	return res, nil
+}
+
+func (u *UuidGrpc) EncodeObfuscatedId(ctx context.Context, req *uuidv1.EncodeObfuscatedIdRequest) (*uuidv1.EncodeObfuscatedIdResponse, error) {
+	res, err := u.uuid.EncodeObfuscatedId(ctx, req)
+	if err != nil {
+		xlog.Error("encodeObfuscatedId failed", zap.Error(err), zap.Any("res", res), zap.Any("req", req))
+		return nil, grpcError(err)
+	}
+
+	return res, nil
+}
+
+func (u *UuidGrpc) DecodeObfuscatedId(ctx context.Context, req *uuidv1.DecodeObfuscatedIdRequest) (*uuidv1.DecodeObfuscatedIdResponse, error) {
+	res, err := u.uuid.DecodeObfuscatedId(ctx, req)
+	if err != nil {
+		xlog.Error("decodeObfuscatedId failed", zap.Error(err), zap.Any("res", res), zap.Any("req", req))
+		return nil, grpcError(err)
+	}
+
+	return res, nil
+}
+
+func (u *UuidGrpc) GetSegmentId(ctx context.Context, req *uuidv1.GetSegmentIdRequest) (*uuidv1.GetSegmentIdResponse, error) {
+	res, err := u.uuid.GetSegmentId(ctx, req)
+	if err != nil {
+		xlog.Error("getSegmentId failed", zap.Error(err), zap.Any("res", res), zap.Any("req", req))
+		return nil, grpcError(err)
+	}
+
+	return res, nil
+}
+
+func (u *UuidGrpc) GetSequence(ctx context.Context, req *uuidv1.GetSequenceRequest) (*uuidv1.GetSequenceResponse, error) {
+	res, err := u.uuid.GetSequence(ctx, req)
+	if err != nil {
+		xlog.Error("getSequence failed", zap.Error(err), zap.Any("res", res), zap.Any("req", req))
+		return nil, grpcError(err)
+	}
+
+	return res, nil
+}
+
+func (u *UuidGrpc) LeaseNodeId(ctx context.Context, req *uuidv1.LeaseNodeIdRequest) (*uuidv1.LeaseNodeIdResponse, error) {
+	res, err := u.uuid.LeaseNodeId(ctx, req)
+	if err != nil {
+		xlog.Error("leaseNodeId failed", zap.Error(err), zap.Any("res", res), zap.Any("req", req))
+		return nil, grpcError(err)
+	}
+
+	return res, nil
+}
+
+func (u *UuidGrpc) RenewNodeLease(ctx context.Context, req *uuidv1.RenewNodeLeaseRequest) (*uuidv1.RenewNodeLeaseResponse, error) {
+	res, err := u.uuid.RenewNodeLease(ctx, req)
+	if err != nil {
+		xlog.Error("renewNodeLease failed", zap.Error(err), zap.Any("res", res), zap.Any("req", req))
+		return nil, grpcError(err)
+	}
+
+	return res, nil
+}
+
+func (u *UuidGrpc) ReleaseNodeLease(ctx context.Context, req *uuidv1.ReleaseNodeLeaseRequest) (*uuidv1.ReleaseNodeLeaseResponse, error) {
+	res, err := u.uuid.ReleaseNodeLease(ctx, req)
+	if err != nil {
+		xlog.Error("releaseNodeLease failed", zap.Error(err), zap.Any("res", res), zap.Any("req", req))
+		return nil, grpcError(err)
+	}
+
+	return res, nil
+}
+
+func (u *UuidGrpc) ListNodeLeases(ctx context.Context, req *uuidv1.ListNodeLeasesRequest) (*uuidv1.ListNodeLeasesResponse, error) {
+	res, err := u.uuid.ListNodeLeases(ctx, req)
+	if err != nil {
+		xlog.Error("listNodeLeases failed", zap.Error(err), zap.Any("res", res), zap.Any("req", req))
+		return nil, grpcError(err)
 	}
 
 	return res, nil
 }
+
+// idempotencyKey the idempotency key of the metadata, the Idempotency-Key header of an http request
+func idempotencyKey(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if keys := md.Get(idempotencyKeyMetadata); len(keys) > 0 {
+		return keys[0]
+	}
+	return ""
+}
+
+// grpcError the grpc status of an error of the service, its ErrorCode is the detail
+func grpcError(err error) error {
+	code, grpcCode := service.ErrorCode(err)
+	st := status.New(grpcCode, xerror.Convert(err).GetMsg())
+	if detailed, err := st.WithDetails(&uuidv1.ErrorDetail{Code: code}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
@@ -1,46 +1,141 @@
 package controller
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
+	"net/textproto"
+	"regexp"
 
 	uuidv1 "github.com/douyu/jupiter-examples/uuid/gen/api/go/uuid/v1"
-	"github.com/douyu/jupiter-examples/uuid/internal/app/uuidserver/service"
 	"github.com/douyu/jupiter/pkg/util/xerror"
 	"github.com/douyu/jupiter/pkg/xlog"
-	"github.com/labstack/echo/v4"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
+const (
+	// idempotencyKeyHeader the http header of the idempotency key, the idempotency_key query param wins over it
+	idempotencyKeyHeader = "Idempotency-Key"
+	// idempotencyKeyMetadata the grpc metadata of the idempotency key, the header is passed on as it
+	idempotencyKeyMetadata = "idempotency-key"
+)
+
+// UuidHTTP serves the rpcs of UuidGrpc over http. The routes and the binding of query params and bodies
+// are generated from the google.api.http annotations of uuid.proto, the responses are written with protojson
 type UuidHTTP struct {
-	uuid *service.Uuid
+	*runtime.ServeMux
 }
 
-func NewUuidHTTPController(uuid *service.Uuid) *UuidHTTP {
-	return &UuidHTTP{
-		uuid: uuid,
+func NewUuidHTTPController(uuid *UuidGrpc) (*UuidHTTP, error) {
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			// the field names of uuid.proto, as the handlers written by hand answered with
+			MarshalOptions:   protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true},
+			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+		}),
+		runtime.WithIncomingHeaderMatcher(incomingHeader),
+		runtime.WithErrorHandler(httpError),
+		runtime.WithRoutingErrorHandler(httpRoutingError),
+	)
+
+	if err := uuidv1.RegisterUuidServiceHandlerServer(context.Background(), mux, uuid); err != nil {
+		return nil, err
 	}
+
+	return &UuidHTTP{
+		ServeMux: mux,
+	}, nil
+}
+
+// HTTPRoute the method and the path of an http route, the variables of the path in the form of echo
+type HTTPRoute struct {
+	Method string
+	Path   string
 }
 
-func (s *UuidHTTP) GetUuidBySnowflake(c echo.Context) error {
-	req := &uuidv1.GetUuidBySnowflakeRequest{}
+// pathVariable a variable of a path template of google.api.http, such as {id}
+var pathVariable = regexp.MustCompile(`\{([^}=]+)(=[^}]*)?\}`)
+
+// Routes the routes of the rpcs annotated with google.api.http in uuid.proto, the additional bindings included.
+// The http server mounts the gateway on these only, the other paths stay with the server
+func (s *UuidHTTP) Routes() []HTTPRoute {
+	var routes []HTTPRoute
+	methods := uuidv1.File_uuid_v1_uuid_proto.Services().ByName("UuidService").Methods()
+	for i := 0; i < methods.Len(); i++ {
+		rule, ok := proto.GetExtension(methods.Get(i).Options(), annotations.E_Http).(*annotations.HttpRule)
+		if !ok || rule == nil {
+			continue
+		}
 
-	res, err := s.uuid.GetUuidBySnowflake(c.Request().Context(), req)
-	if err != nil {
-		xlog.Error("getUuidBySnowflake failed", zap.Error(err), zap.Any("res", res), zap.Any("req", req))
-		return c.JSON(http.StatusOK, err)
+		for _, rule := range append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...) {
+			var method, path string
+			switch pattern := rule.GetPattern().(type) {
+			case *annotations.HttpRule_Get:
+				method, path = http.MethodGet, pattern.Get
+			case *annotations.HttpRule_Post:
+				method, path = http.MethodPost, pattern.Post
+			case *annotations.HttpRule_Put:
+				method, path = http.MethodPut, pattern.Put
+			case *annotations.HttpRule_Delete:
+				method, path = http.MethodDelete, pattern.Delete
+			case *annotations.HttpRule_Patch:
+				method, path = http.MethodPatch, pattern.Patch
+			case *annotations.HttpRule_Custom:
+				method, path = pattern.Custom.GetKind(), pattern.Custom.GetPath()
+			default:
+				continue
+			}
+			routes = append(routes, HTTPRoute{Method: method, Path: pathVariable.ReplaceAllString(path, ":$1")})
+		}
 	}
+	return routes
+}
 
-	return c.JSON(http.StatusOK, xerror.OK.WithData(res))
+// incomingHeader passes Idempotency-Key on to the rpcs, besides the headers the gateway passes on by default
+func incomingHeader(key string) (string, bool) {
+	if textproto.CanonicalMIMEHeaderKey(key) == idempotencyKeyHeader {
+		return idempotencyKeyMetadata, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
 }
 
-func (s *UuidHTTP) GetUuidByGoogleUUIDV4(c echo.Context) error {
-	req := &uuidv1.GetUuidByGoogleUUIDV4Request{}
+// httpError answers a failed rpc with the http status of its grpc code and its ErrorCode in the envelope the
+// responses of the rpcs have. The errors of the gateway itself, such as a query param of the wrong type, carry no ErrorCode
+func httpError(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	st := status.Convert(err)
 
-	res, err := s.uuid.GetUuidByGoogleUUIDV4(c.Request().Context(), req)
-	if err != nil {
-		xlog.Error("getUuidByGoogleUUIDV4 failed", zap.Error(err), zap.Any("res", res), zap.Any("req", req))
-		return c.JSON(http.StatusOK, err)
+	code := uuidv1.ErrorCode_ERROR_CODE_INTERNAL
+	if st.Code() == codes.InvalidArgument {
+		code = uuidv1.ErrorCode_ERROR_CODE_INVALID_REQUEST
+	}
+	detailed := false
+	for _, detail := range st.Details() {
+		if detail, ok := detail.(*uuidv1.ErrorDetail); ok {
+			code, detailed = detail.GetCode(), true
+		}
+	}
+	if !detailed {
+		// the failed rpcs are logged by UuidGrpc already
+		xlog.Error("http gateway failed", zap.Error(err), zap.String("method", r.Method), zap.String("path", r.URL.Path))
 	}
 
-	return c.JSON(http.StatusOK, xerror.OK.WithData(res))
+	writeHTTPError(w, runtime.HTTPStatusFromCode(st.Code()), code, st.Message())
+}
+
+// httpRoutingError answers a path or method that no rpc is annotated with
+func httpRoutingError(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, httpStatus int) {
+	writeHTTPError(w, httpStatus, uuidv1.ErrorCode_ERROR_CODE_UNKNOWN_ROUTE, http.StatusText(httpStatus))
+}
+
+func writeHTTPError(w http.ResponseWriter, httpStatus int, code uuidv1.ErrorCode, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	// data is null, error and msg are the ones of the ErrorCode
+	json.NewEncoder(w).Encode(&xerror.Err{Ecode: int32(code), Msg: msg})
 }
@@ -0,0 +1,181 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	uuidv1 "github.com/douyu/jupiter-examples/uuid/gen/api/go/uuid/v1"
+	"github.com/douyu/jupiter-examples/uuid/internal/app/uuidserver/service"
+	"github.com/douyu/jupiter-examples/uuid/internal/pkg/resp"
+	"github.com/douyu/jupiter/pkg/util/xerror"
+	"github.com/douyu/jupiter/pkg/xlog"
+	"go.uber.org/zap"
+)
+
+// UuidResp answers the commands of redis clients
+type UuidResp struct {
+	uuid     *service.Uuid
+	commands map[string]func(ctx context.Context, w *resp.Writer, args []string)
+}
+
+func NewUuidRespController(uuid *service.Uuid) *UuidResp {
+	s := &UuidResp{
+		uuid: uuid,
+	}
+	s.commands = map[string]func(ctx context.Context, w *resp.Writer, args []string){
+		"PING":            s.Ping,
+		"SNOWFLAKE":       s.Snowflake,
+		"SNOWFLAKE.BATCH": s.SnowflakeBatch,
+		"UUIDV4":          s.UuidV4,
+		"PARSE":           s.Parse,
+	}
+	return s
+}
+
+// Serve answers the command of args, the name is matched case-insensitively as redis does.
+// It returns false once the client asked to close the connection
+func (s *UuidResp) Serve(ctx context.Context, w *resp.Writer, args []string) bool {
+	name := strings.ToUpper(args[0])
+	if name == "QUIT" {
+		w.WriteString("OK")
+		return false
+	}
+
+	command, ok := s.commands[name]
+	if !ok {
+		w.WriteError(fmt.Sprintf("ERR unknown command '%s'", args[0]))
+		return true
+	}
+	command(ctx, w, args[1:])
+	return true
+}
+
+// Ping PING [message]
+func (s *UuidResp) Ping(ctx context.Context, w *resp.Writer, args []string) {
+	switch len(args) {
+	case 0:
+		w.WriteString("PONG")
+	case 1:
+		w.WriteBulk(args[0])
+	default:
+		wrongArgs(w, "ping")
+	}
+}
+
+// Snowflake SNOWFLAKE [generator], the id as an integer
+func (s *UuidResp) Snowflake(ctx context.Context, w *resp.Writer, args []string) {
+	if len(args) > 1 {
+		wrongArgs(w, "snowflake")
+		return
+	}
+
+	req := &uuidv1.GetUuidBySnowflakeRequest{}
+	if len(args) == 1 {
+		req.Generator = args[0]
+	}
+
+	res, err := s.uuid.GetUuidBySnowflake(ctx, req)
+	if err != nil {
+		xlog.Error("getUuidBySnowflake failed", zap.Error(err), zap.Any("res", res), zap.Any("req", req))
+		writeError(w, err)
+		return
+	}
+
+	w.WriteInt(int64(res.GetData().GetId()))
+}
+
+// SnowflakeBatch SNOWFLAKE.BATCH count [generator], the ids as an array of integers
+func (s *UuidResp) SnowflakeBatch(ctx context.Context, w *resp.Writer, args []string) {
+	if len(args) < 1 || len(args) > 2 {
+		wrongArgs(w, "snowflake.batch")
+		return
+	}
+
+	count, err := strconv.ParseUint(args[0], 10, 32)
+	if err != nil {
+		w.WriteError(fmt.Sprintf("ERR count must be a positive integer (ecode %d)", uuidv1.ErrorCode_ERROR_CODE_INVALID_REQUEST))
+		return
+	}
+
+	req := &uuidv1.GetUuidBySnowflakeBatchRequest{Count: uint32(count)}
+	if len(args) == 2 {
+		req.Generator = args[1]
+	}
+
+	res, err := s.uuid.GetUuidBySnowflakeBatch(ctx, req)
+	if err != nil {
+		xlog.Error("getUuidBySnowflakeBatch failed", zap.Error(err), zap.Any("res", res), zap.Any("req", req))
+		writeError(w, err)
+		return
+	}
+
+	ids := res.GetData().GetIds()
+	w.WriteArray(len(ids))
+	for _, id := range ids {
+		w.WriteInt(int64(id))
+	}
+}
+
+// UuidV4 UUIDV4, a random uuid as a bulk string
+func (s *UuidResp) UuidV4(ctx context.Context, w *resp.Writer, args []string) {
+	if len(args) != 0 {
+		wrongArgs(w, "uuidv4")
+		return
+	}
+
+	req := &uuidv1.GetUuidByGoogleUUIDV4Request{}
+	res, err := s.uuid.GetUuidByGoogleUUIDV4(ctx, req)
+	if err != nil {
+		xlog.Error("getUuidByGoogleUUIDV4 failed", zap.Error(err), zap.Any("res", res), zap.Any("req", req))
+		writeError(w, err)
+		return
+	}
+
+	w.WriteBulk(res.GetData().GetUuid())
+}
+
+// Parse PARSE id [generator], the parts of a snowflake id as field value pairs, the way HGETALL answers
+func (s *UuidResp) Parse(ctx context.Context, w *resp.Writer, args []string) {
+	if len(args) < 1 || len(args) > 2 {
+		wrongArgs(w, "parse")
+		return
+	}
+
+	req := &uuidv1.ParseSnowflakeRequest{Uuid: args[0]}
+	if len(args) == 2 {
+		req.Generator = args[1]
+	}
+
+	res, err := s.uuid.ParseSnowflake(ctx, req)
+	if err != nil {
+		xlog.Error("parseSnowflake failed", zap.Error(err), zap.Any("res", res), zap.Any("req", req))
+		writeError(w, err)
+		return
+	}
+
+	data := res.GetData()
+	fields := []string{
+		"timestamp", strconv.FormatInt(data.GetTimestamp(), 10),
+		"time", data.GetTime(),
+		"node_id", strconv.FormatInt(data.GetNodeId(), 10),
+		"step", strconv.FormatInt(data.GetStep(), 10),
+		"datacenter_id", strconv.FormatInt(data.GetDatacenterId(), 10),
+		"datacenter", data.GetDatacenter(),
+	}
+	w.WriteArray(len(fields))
+	for _, field := range fields {
+		w.WriteBulk(field)
+	}
+}
+
+func wrongArgs(w *resp.Writer, command string) {
+	w.WriteError(fmt.Sprintf("ERR wrong number of arguments for '%s' command", command))
+}
+
+// writeError the error of the service with its ErrorCode, the way the go client prints it
+func writeError(w *resp.Writer, err error) {
+	code, _ := service.ErrorCode(err)
+	w.WriteError(fmt.Sprintf("ERR %s (ecode %d)", xerror.Convert(err).GetMsg(), code))
+}
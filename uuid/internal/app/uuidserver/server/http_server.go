@@ -14,13 +14,12 @@ type HttpServer struct {
 func NewHttpServer(opts controller.Options) *HttpServer {
 	s := xecho.StdConfig("http").MustBuild()
 
-	s.GET("/snowflake_uuid", func(c echo.Context) error {
-		return opts.UuidHTTP.GetUuidBySnowflake(c)
-	})
-
-	s.GET("/google_uuid_v4", func(c echo.Context) error {
-		return opts.UuidHTTP.GetUuidByGoogleUUIDV4(c)
-	})
+	// the routes of the rpcs are generated from the google.api.http annotations of uuid.proto,
+	// the paths of no rpc are answered by echo
+	gateway := echo.WrapHandler(opts.UuidHTTP)
+	for _, route := range opts.UuidHTTP.Routes() {
+		s.Add(route.Method, route.Path, gateway)
+	}
 
 	return &HttpServer{
 		Server: s,
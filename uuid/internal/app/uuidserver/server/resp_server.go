@@ -0,0 +1,28 @@
+package server
+
+import (
+	"github.com/douyu/jupiter-examples/uuid/internal/app/uuidserver/controller"
+	"github.com/douyu/jupiter-examples/uuid/internal/pkg/resp"
+)
+
+// RespServer answers SNOWFLAKE, SNOWFLAKE.BATCH, UUIDV4 and PARSE over the redis protocol,
+// for clients that have a redis client but no grpc
+type RespServer struct {
+	*resp.Server
+}
+
+// NewRespServer the listener of jupiter.server.resp, nil unless it is enabled
+func NewRespServer(opts controller.Options) (*RespServer, error) {
+	config := resp.StdConfig("resp")
+	if !config.Enable {
+		return nil, nil
+	}
+
+	server, err := config.Build(opts.UuidResp)
+	if err != nil {
+		return nil, err
+	}
+	return &RespServer{
+		Server: server,
+	}, nil
+}
@@ -10,11 +10,23 @@ var ProviderSet = wire.NewSet(
 	wire.Struct(new(controller.Options), "*"),
 	NewGrpcServer,
 	NewHttpServer,
+	NewRespServer,
 )
 
 type Options struct {
 	http *HttpServer
 	grpc *GrpcServer
+	resp *RespServer
+}
+
+// InitApp builds the servers and registers them to app, it fails if the uuid service cannot get a node id
+func InitApp(app *jupiter.Application) error {
+	opts, err := initOptions()
+	if err != nil {
+		return err
+	}
+
+	return initApp(app, opts)
 }
 
 func initApp(app *jupiter.Application, opts Options) error {
@@ -28,5 +40,12 @@ func initApp(app *jupiter.Application, opts Options) error {
 		return err
 	}
 
+	// resp, only if enabled
+	if opts.resp != nil {
+		if err := app.Serve(opts.resp); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -9,30 +9,47 @@ package server
 import (
 	"github.com/douyu/jupiter-examples/uuid/internal/app/uuidserver/controller"
 	"github.com/douyu/jupiter-examples/uuid/internal/app/uuidserver/service"
+	"github.com/douyu/jupiter-examples/uuid/internal/pkg/etcd"
+	"github.com/douyu/jupiter-examples/uuid/internal/pkg/mysql"
 	"github.com/douyu/jupiter-examples/uuid/internal/pkg/redis"
-	"github.com/douyu/jupiter/pkg/core/application"
 )
 
 // Injectors from wire.go:
 
-func InitApp(app *application.Application) error {
+func initOptions() (Options, error) {
 	redisInterface := redis.NewRedis()
+	etcdInterface := etcd.NewEtcd()
+	mysqlInterface := mysql.NewMysql()
 	options := service.Options{
 		Redis: redisInterface,
+		Etcd:  etcdInterface,
+		Mysql: mysqlInterface,
+	}
+	uuid, err := service.NewUuidService(options)
+	if err != nil {
+		return Options{}, err
 	}
-	uuid := service.NewUuidService(options)
-	uuidHTTP := controller.NewUuidHTTPController(uuid)
 	uuidGrpc := controller.NewUUuidGrpcController(uuid)
+	uuidHTTP, err := controller.NewUuidHTTPController(uuidGrpc)
+	if err != nil {
+		return Options{}, err
+	}
+	uuidResp := controller.NewUuidRespController(uuid)
 	controllerOptions := controller.Options{
 		UuidHTTP: uuidHTTP,
 		UuidGrpc: uuidGrpc,
+		UuidResp: uuidResp,
 	}
 	httpServer := NewHttpServer(controllerOptions)
 	grpcServer := NewGrpcServer(controllerOptions)
+	respServer, err := NewRespServer(controllerOptions)
+	if err != nil {
+		return Options{}, err
+	}
 	serverOptions := Options{
 		http: httpServer,
 		grpc: grpcServer,
+		resp: respServer,
 	}
-	error2 := initApp(app, serverOptions)
-	return error2
+	return serverOptions, nil
 }
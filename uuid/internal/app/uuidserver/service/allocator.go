@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"fmt"
+)
+
+// The node id allocators that can be set as nodeAllocator in config
+const (
+	AllocatorStatic   = "static"
+	AllocatorHostname = "hostname"
+	AllocatorIP       = "ip"
+	AllocatorRedis    = "redis"
+	AllocatorEtcd     = "etcd"
+)
+
+// NodeIDAllocator picks the snowflake node id of this instance
+type NodeIDAllocator interface {
+	// Name the strategy name, as set by nodeAllocator in config
+	Name() string
+	// Allocate picks a node id in [0, maxNodeId]
+	Allocate(ctx context.Context, maxNodeId int64) (*NodeID, error)
+}
+
+// NodeID a node id picked by a NodeIDAllocator
+type NodeID struct {
+	ID int64
+	// Reason tells where the id comes from, it is logged when the service starts
+	Reason string
+	// Lease is set when the id is claimed from a shared backend, the id must not be used once it is no longer valid
+	Lease NodeLease
+}
+
+// newNodeIDAllocator builds the allocator chosen by config
+func newNodeIDAllocator(config *Config, options Options) (NodeIDAllocator, error) {
+	switch config.nodeAllocator() {
+	case AllocatorStatic:
+		return &staticAllocator{nodeId: config.NodeID}, nil
+	case AllocatorHostname:
+		return &hostnameAllocator{hostname: config.Hostname, offset: config.OrdinalOffset}, nil
+	case AllocatorIP:
+		return &ipAllocator{ip: config.NodeIP}, nil
+	case AllocatorRedis:
+		return &redisAllocator{redis: options.Redis, ttl: config.NodeLeaseTTL}, nil
+	case AllocatorEtcd:
+		return &etcdAllocator{etcd: options.Etcd, ttl: config.NodeLeaseTTL}, nil
+	}
+
+	return nil, fmt.Errorf("snowflake NodeAllocator:%v err,unknown node id allocator", config.NodeAllocator)
+}
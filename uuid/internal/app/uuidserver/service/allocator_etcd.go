@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	etcdCli "github.com/douyu/jupiter-examples/uuid/internal/pkg/etcd"
+	"github.com/douyu/jupiter/pkg/xlog"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+)
+
+// etcdAllocator claims the lowest free node id in etcd under a lease
+type etcdAllocator struct {
+	etcd etcdCli.EtcdInterface
+	ttl  time.Duration
+}
+
+func (a *etcdAllocator) Name() string {
+	return AllocatorEtcd
+}
+
+func (a *etcdAllocator) Allocate(ctx context.Context, maxNodeId int64) (*NodeID, error) {
+	lease, err := acquireEtcdNodeLease(ctx, a.etcd, maxNodeId, a.ttl)
+	if errors.Is(err, etcdCli.ErrNodeIdExhausted) {
+		return nil, errNodeIdExhausted(maxNodeId)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get etcd node id is %v", err)
+	}
+
+	return &NodeID{
+		ID:     lease.nodeId,
+		Reason: fmt.Sprintf("lowest free node id in etcd, claimed by %s under lease %x", lease.owner, int64(lease.leaseId)),
+		Lease:  lease,
+	}, nil
+}
+
+// etcdNodeLease holds a node id claimed under an etcd lease, the etcd client keeps the lease alive until it is released
+type etcdNodeLease struct {
+	etcd    etcdCli.EtcdInterface
+	nodeId  int64
+	owner   string
+	leaseId clientv3.LeaseID
+
+	// deadline unix nano until which the node id is known to be ours
+	deadline int64
+
+	// keepAliveCtx is canceled by Release
+	keepAliveCtx context.Context
+	cancel       context.CancelFunc
+	lost         chan struct{}
+	done         chan struct{}
+	closeOnce    sync.Once
+}
+
+// acquireEtcdNodeLease claims a node id in [1, maxNodeId] and keeps its lease alive
+func acquireEtcdNodeLease(ctx context.Context, etcd etcdCli.EtcdInterface, maxNodeId int64, ttl time.Duration) (*etcdNodeLease, error) {
+	lease := &etcdNodeLease{
+		etcd:  etcd,
+		owner: newLeaseOwner(),
+		lost:  make(chan struct{}),
+		done:  make(chan struct{}),
+	}
+
+	start := time.Now()
+	nodeId, leaseId, err := etcd.AcquireNodeId(ctx, maxNodeId, lease.owner, ttl)
+	if err != nil {
+		return nil, err
+	}
+	lease.nodeId = nodeId
+	lease.leaseId = leaseId
+	atomic.StoreInt64(&lease.deadline, start.Add(ttl).UnixNano())
+
+	// the keep alive outlives ctx, it runs until the lease is released
+	keepAliveCtx, cancel := context.WithCancel(context.Background())
+	keepAlive, err := etcd.KeepAliveNodeId(keepAliveCtx, leaseId)
+	if err != nil {
+		cancel()
+		_ = etcd.ReleaseNodeId(context.TODO(), leaseId)
+		return nil, err
+	}
+	lease.keepAliveCtx, lease.cancel = keepAliveCtx, cancel
+
+	go lease.watch(keepAlive)
+
+	return lease, nil
+}
+
+// NodeId the node id claimed in etcd
+func (l *etcdNodeLease) NodeId() int64 {
+	return l.nodeId
+}
+
+// Valid reports whether the lease is still known to be held
+func (l *etcdNodeLease) Valid() bool {
+	return time.Now().UnixNano() < atomic.LoadInt64(&l.deadline)
+}
+
+// Lost is closed once the keep alive ended other than by Release
+func (l *etcdNodeLease) Lost() <-chan struct{} {
+	return l.lost
+}
+
+func (l *etcdNodeLease) watch(keepAlive <-chan *clientv3.LeaseKeepAliveResponse) {
+	defer close(l.done)
+
+	for res := range keepAlive {
+		// the ttl restarts when etcd receives the keep alive, which is a bit earlier than now
+		atomic.StoreInt64(&l.deadline, time.Now().Add(time.Duration(res.TTL)*time.Second*9/10).UnixNano())
+	}
+
+	// the channel is closed once the lease expired, was revoked or the keep alive got canceled,
+	// in all cases the node id key is gone or about to be
+	atomic.StoreInt64(&l.deadline, 0)
+	xlog.Warn("node id lease closed", zap.Int64("nodeId", l.nodeId), zap.String("owner", l.owner), zap.Int64("leaseId", int64(l.leaseId)))
+	if l.keepAliveCtx.Err() == nil {
+		close(l.lost)
+	}
+}
+
+// Release stops the keep alive and revokes the lease, which deletes the node id key with it
+func (l *etcdNodeLease) Release() {
+	l.closeOnce.Do(func() {
+		l.cancel()
+		<-l.done
+
+		if err := l.etcd.ReleaseNodeId(context.TODO(), l.leaseId); err != nil {
+			xlog.Error("release node id lease failed", zap.Error(err), zap.Int64("nodeId", l.nodeId), zap.String("owner", l.owner))
+		}
+	})
+}
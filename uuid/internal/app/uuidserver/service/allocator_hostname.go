@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// hostnameAllocator derives the node id from the ordinal a StatefulSet puts at the end of the pod name, e.g. uuidserver-3
+type hostnameAllocator struct {
+	// hostname overrides os.Hostname
+	hostname string
+	// offset is added to the ordinal, so the first pod doesn't get node id 0
+	offset int64
+}
+
+func (a *hostnameAllocator) Name() string {
+	return AllocatorHostname
+}
+
+func (a *hostnameAllocator) Allocate(ctx context.Context, maxNodeId int64) (*NodeID, error) {
+	hostname := a.hostname
+	if hostname == "" {
+		var err error
+		if hostname, err = os.Hostname(); err != nil {
+			return nil, err
+		}
+	}
+
+	ordinal, err := parseOrdinal(hostname)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeId := ordinal + a.offset
+	if nodeId < 0 || nodeId > maxNodeId {
+		return nil, fmt.Errorf("hostname %q ordinal %d with offset %d gives node id %d, must be between 0 and %d", hostname, ordinal, a.offset, nodeId, maxNodeId)
+	}
+
+	return &NodeID{
+		ID:     nodeId,
+		Reason: fmt.Sprintf("ordinal %d of hostname %q plus offset %d", ordinal, hostname, a.offset),
+	}, nil
+}
+
+// parseOrdinal returns the number after the last dash of a StatefulSet pod name
+func parseOrdinal(hostname string) (int64, error) {
+	i := strings.LastIndex(hostname, "-")
+	if i < 0 {
+		return 0, fmt.Errorf("hostname %q has no StatefulSet ordinal", hostname)
+	}
+
+	ordinal, err := strconv.ParseInt(hostname[i+1:], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("hostname %q has no StatefulSet ordinal", hostname)
+	}
+
+	return ordinal, nil
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+
+	"github.com/douyu/jupiter/pkg/util/xnet"
+)
+
+// ipAllocator takes the low bits of the pod ip as node id.
+// It's unique as long as every instance sits in one subnet no larger than the node id range.
+type ipAllocator struct {
+	// ip overrides the detected pod ip
+	ip string
+}
+
+func (a *ipAllocator) Name() string {
+	return AllocatorIP
+}
+
+func (a *ipAllocator) Allocate(ctx context.Context, maxNodeId int64) (*NodeID, error) {
+	ip, source := a.ip, "nodeIp config"
+	if ip == "" {
+		// set through the downward api in kubernetes
+		ip, source = os.Getenv("POD_IP"), "POD_IP env"
+	}
+	if ip == "" {
+		var err error
+		if ip, _, err = xnet.GetLocalMainIP(); err != nil {
+			return nil, err
+		}
+		source = "main network interface"
+	}
+
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return nil, fmt.Errorf("ip %q from %s is invalid", ip, source)
+	}
+	if v4 := parsed.To4(); v4 != nil {
+		parsed = v4
+	}
+
+	// maxNodeId is a mask of NodeBits ones
+	nodeId := new(big.Int).And(new(big.Int).SetBytes(parsed), big.NewInt(maxNodeId)).Int64()
+
+	return &NodeID{
+		ID:     nodeId,
+		Reason: fmt.Sprintf("low bits of ip %s from %s", ip, source),
+	}, nil
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	redisCli "github.com/douyu/jupiter-examples/uuid/internal/pkg/redis"
+	"github.com/douyu/jupiter/pkg/xlog"
+	"go.uber.org/zap"
+)
+
+// redisAllocator leases the lowest free node id from redis
+type redisAllocator struct {
+	redis redisCli.RedisInterface
+	ttl   time.Duration
+}
+
+func (a *redisAllocator) Name() string {
+	return AllocatorRedis
+}
+
+func (a *redisAllocator) Allocate(ctx context.Context, maxNodeId int64) (*NodeID, error) {
+	lease, err := acquireRedisNodeLease(ctx, a.redis, maxNodeId, a.ttl)
+	if errors.Is(err, redisCli.ErrNodeIdExhausted) {
+		return nil, errNodeIdExhausted(maxNodeId)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get redis node id is %v", err)
+	}
+
+	return &NodeID{
+		ID:     lease.nodeId,
+		Reason: fmt.Sprintf("lowest free node id in redis, leased by %s for %v", lease.owner, a.ttl),
+		Lease:  lease,
+	}, nil
+}
+
+// redisNodeLease holds a node id leased from redis, a heartbeat keeps it alive until it is released
+type redisNodeLease struct {
+	redis  redisCli.RedisInterface
+	nodeId int64
+	owner  string
+	ttl    time.Duration
+
+	// deadline unix nano until which the node id is known to be ours
+	deadline int64
+
+	lost      chan struct{}
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// acquireRedisNodeLease claims a node id in [1, maxNodeId] and starts renewing it every ttl/3
+func acquireRedisNodeLease(ctx context.Context, redis redisCli.RedisInterface, maxNodeId int64, ttl time.Duration) (*redisNodeLease, error) {
+	lease := &redisNodeLease{
+		redis: redis,
+		owner: newLeaseOwner(),
+		ttl:   ttl,
+		lost:  make(chan struct{}),
+		stop:  make(chan struct{}),
+		done:  make(chan struct{}),
+	}
+
+	start := time.Now()
+	nodeId, err := redis.AcquireNodeId(ctx, maxNodeId, lease.owner, ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	lease.nodeId = nodeId
+	// count the ttl from before the call, the lease may have been set at any moment of it
+	atomic.StoreInt64(&lease.deadline, start.Add(ttl).UnixNano())
+
+	go lease.heartbeat()
+
+	return lease, nil
+}
+
+// NodeId the node id leased from redis
+func (l *redisNodeLease) NodeId() int64 {
+	return l.nodeId
+}
+
+// Valid reports whether the lease is still known to be held
+func (l *redisNodeLease) Valid() bool {
+	return time.Now().UnixNano() < atomic.LoadInt64(&l.deadline)
+}
+
+// Lost is closed once a renewal found the node id key gone or taken over
+func (l *redisNodeLease) Lost() <-chan struct{} {
+	return l.lost
+}
+
+func (l *redisNodeLease) heartbeat() {
+	defer close(l.done)
+
+	ticker := time.NewTicker(l.ttl / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-l.stop:
+			return
+		case <-ticker.C:
+		}
+
+		start := time.Now()
+		err := l.redis.RenewNodeId(context.TODO(), l.nodeId, l.owner, l.ttl)
+		if err == redisCli.ErrNodeLeaseLost {
+			// someone else may already hold this node id, stop issuing ids right away
+			atomic.StoreInt64(&l.deadline, 0)
+			xlog.Error("node id lease lost", zap.Int64("nodeId", l.nodeId), zap.String("owner", l.owner))
+			close(l.lost)
+			return
+		}
+		if err != nil {
+			// keep the old deadline, the next tick retries
+			xlog.Warn("renew node id lease failed", zap.Error(err), zap.Int64("nodeId", l.nodeId), zap.String("owner", l.owner))
+			continue
+		}
+
+		atomic.StoreInt64(&l.deadline, start.Add(l.ttl).UnixNano())
+	}
+}
+
+// Release stops the heartbeat and gives the node id back to redis
+func (l *redisNodeLease) Release() {
+	l.closeOnce.Do(func() {
+		close(l.stop)
+		<-l.done
+
+		atomic.StoreInt64(&l.deadline, 0)
+		if err := l.redis.ReleaseNodeId(context.TODO(), l.nodeId, l.owner); err != nil {
+			xlog.Error("release node id lease failed", zap.Error(err), zap.Int64("nodeId", l.nodeId), zap.String("owner", l.owner))
+		}
+	})
+}
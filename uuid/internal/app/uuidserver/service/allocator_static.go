@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+	"fmt"
+)
+
+// staticAllocator uses the node id set by the nodeId config or flag
+type staticAllocator struct {
+	nodeId int64
+}
+
+func (a *staticAllocator) Name() string {
+	return AllocatorStatic
+}
+
+func (a *staticAllocator) Allocate(ctx context.Context, maxNodeId int64) (*NodeID, error) {
+	if a.nodeId < 0 || a.nodeId > maxNodeId {
+		return nil, fmt.Errorf("snowflake NodeID:%v err,must be between 0 and %v", a.nodeId, maxNodeId)
+	}
+
+	return &NodeID{
+		ID:     a.nodeId,
+		Reason: "configured by nodeId",
+	}, nil
+}
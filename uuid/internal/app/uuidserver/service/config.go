@@ -3,8 +3,10 @@ package service
 import (
 	"fmt"
 	"sync"
+	"time"
 
-	"github.com/bwmarrin/snowflake"
+	xsnowflake "github.com/douyu/jupiter-examples/uuid/pkg/snowflake"
+	"github.com/douyu/jupiter/pkg"
 	"github.com/douyu/jupiter/pkg/conf"
 	"github.com/douyu/jupiter/pkg/core/ecode"
 	"github.com/douyu/jupiter/pkg/flag"
@@ -38,23 +40,126 @@ type Config struct {
 	// StepBits holds the number of bits to use for Step
 	// Remember, you have a total 22 bits to share between Node/Step
 	StepBits uint8
+	// DatacenterBits holds the number of bits to use for the datacenter, 0 keeps the layout without one.
+	// Remember, Datacenter/Node/Step share the 22 bits then
+	DatacenterBits uint8
+	// Datacenters the datacenter id of every region, keyed by "region/zone" or "region" of APP_REGION and APP_ZONE
+	Datacenters map[string]int64
+	// DatacenterID the datacenter id if Datacenters is empty
+	DatacenterID int64
+
+	// Generators the names of further generators, each with a layout of its own under [jupiter.server.uuid.<name>].
+	// Requests pick one by name, the layout above serves the requests without a name
+	Generators []string
+	// layouts the layouts of Generators by name, they are read by RawConfig
+	layouts map[string]LayoutConfig
 
 	// NodeID determine the specific value of the node id; 0 nodeID are not used by default
 	NodeID int64
 
+	// NodeAllocator how the node id is picked: static, hostname, ip, redis or etcd.
+	// It defaults to redis or etcd if they are enabled, to static otherwise
+	NodeAllocator string
+	// Hostname overrides os.Hostname for the hostname allocator
+	Hostname string
+	// OrdinalOffset is added to the StatefulSet ordinal by the hostname allocator
+	OrdinalOffset int64
+	// NodeIP overrides the pod ip for the ip allocator, which otherwise reads POD_IP or the main network interface
+	NodeIP string
+
 	// EnableRedis whether to enable the use of redis to assign node IDs
 	EnableRedis bool
 	// RedisAddr redis addr, default to 'Host:Port'
 	RedisAddr string
+	// EnableEtcd whether to claim node IDs in the etcd of jupiter.registry.default
+	EnableEtcd bool
+	// ClockRollbackPolicy what to do once the clock is behind the last id issued:
+	// block until it caught up, error with ErrClockRollback, or borrow from a logical clock up to ClockRollbackBorrowLimit
+	ClockRollbackPolicy string
+	// ClockRollbackBorrowLimit how far the logical clock of the borrow policy may run ahead of the wall clock
+	ClockRollbackBorrowLimit time.Duration
+
+	// HighWaterMarkStore where the time of the last id is kept across restarts: file, redis or etcd, not kept if empty
+	HighWaterMarkStore string
+	// HighWaterMarkDir the directory of the file store
+	HighWaterMarkDir string
+	// HighWaterMarkInterval how often the mark is saved, it is saved on stop too
+	HighWaterMarkInterval time.Duration
+	// HighWaterMarkPolicy wait or refuse while the clock is not past the mark on start
+	HighWaterMarkPolicy string
+	// HighWaterMarkMaxWait the wait policy refuses to start as well if the clock is further behind
+	HighWaterMarkMaxWait time.Duration
+
+	// MaxBatchSize the most ids GetUuidBySnowflakeBatch and GetUuidByNameBatch hand out per call
+	MaxBatchSize uint32
+
+	// Namespaces the namespaces of name-based uuids by alias, in addition to dns, url, oid and x500
+	Namespaces map[string]string
+
+	// Prefixes the prefixes of public ids and what their ids carry, e.g. ord for the snowflake uuids of orders
+	Prefixes map[string]PrefixConfig
+
+	// ObfuscationKeys the keys of obfuscated ids by key version from 0 to 61, at least 16 bytes each.
+	// Obfuscation is off without keys, keep the keys of retired versions to decode the ids handed out with them
+	ObfuscationKeys map[string]string
+	// ObfuscationKeyVersion the key version new obfuscated ids are encoded with
+	ObfuscationKeyVersion int64
+
+	// EnableSegment hands out the segment ids of leaf_alloc in jupiter.mysql.uuid
+	EnableSegment bool
+	// SegmentPrefetchThreshold the share of the current segment that is used up before the next one is fetched
+	SegmentPrefetchThreshold float64
+	// SegmentFetchTimeout how long fetching a segment may take
+	SegmentFetchTimeout time.Duration
+
+	// IdempotencyStore where the ids handed out for idempotency keys are kept: memory or redis.
+	// Requests with an idempotency key are refused if empty
+	IdempotencyStore string
+	// IdempotencyTTL how long a retry with the same idempotency key gets the same ids
+	IdempotencyTTL time.Duration
+	// IdempotencyMaxKeys the most keys the memory store keeps, new keys are refused while it is full
+	IdempotencyMaxKeys int
+
+	// Sequences the redis sequences by key, e.g. invoices for the invoice numbers
+	Sequences map[string]SequenceConfig
+
+	// NodeLeaseTTL how long a node id assigned by redis or etcd stays reserved without a heartbeat,
+	// the lease is renewed every third of it
+	NodeLeaseTTL time.Duration
+	// EnableNodeLeases leases the free node ids of the redis or etcd allocator to clients, which generate snowflake ids
+	// in-process. The leases of clients last NodeLeaseTTL as well
+	EnableNodeLeases bool
+}
+
+// LayoutConfig the layout of a named generator, the fields it leaves out are taken from [jupiter.server.uuid]
+type LayoutConfig struct {
+	Epoch          int64
+	DatacenterBits uint8
+	NodeBits       uint8
+	StepBits       uint8
 }
 
 // DefaultConfig ...
 func DefaultConfig() *Config {
 	return &Config{
-		Epoch:    snowflake.Epoch,
-		NodeBits: snowflake.NodeBits,
-		StepBits: snowflake.StepBits,
+		Epoch:    xsnowflake.DefaultEpoch,
+		NodeBits: xsnowflake.DefaultNodeBits,
+		StepBits: xsnowflake.DefaultStepBits,
 		NodeID:   flag.Int("nodeId"),
+
+		ClockRollbackPolicy:      string(xsnowflake.RollbackError),
+		ClockRollbackBorrowLimit: time.Second,
+		HighWaterMarkDir:         "data",
+		HighWaterMarkInterval:    time.Second,
+		HighWaterMarkPolicy:      HighWaterMarkWait,
+		HighWaterMarkMaxWait:     10 * time.Second,
+		MaxBatchSize:             10000,
+		SegmentPrefetchThreshold: 0.1,
+		SegmentFetchTimeout:      3 * time.Second,
+		IdempotencyTTL:           time.Hour,
+		IdempotencyMaxKeys:       100000,
+		OrdinalOffset:            1,
+		NodeLeaseTTL:             30 * time.Second,
 	}
 }
 
@@ -70,6 +175,21 @@ func RawConfig(key string) *Config {
 		errors.Cause(err) != conf.ErrInvalidKey {
 		xlog.Panic("http server parse config panic", xlog.FieldErrKind(ecode.ErrKindUnmarshalConfigErr), xlog.FieldErr(err), xlog.FieldKey(key), xlog.FieldValueAny(config))
 	}
+
+	config.layouts = make(map[string]LayoutConfig, len(config.Generators))
+	for _, name := range config.Generators {
+		var layout = LayoutConfig{
+			Epoch:          config.Epoch,
+			DatacenterBits: config.DatacenterBits,
+			NodeBits:       config.NodeBits,
+			StepBits:       config.StepBits,
+		}
+		if err := conf.UnmarshalKey(key+"."+name, &layout); err != nil &&
+			errors.Cause(err) != conf.ErrInvalidKey {
+			xlog.Panic("http server parse config panic", xlog.FieldErrKind(ecode.ErrKindUnmarshalConfigErr), xlog.FieldErr(err), xlog.FieldKey(key+"."+name), xlog.FieldValueAny(layout))
+		}
+		config.layouts[name] = layout
+	}
 	return config
 }
 
@@ -83,20 +203,106 @@ func (config *Config) MustBuild() *Uuid {
 
 // Build create server instance, then initialize it with necessary interceptor
 func (config *Config) Build() (*Uuid, error) {
-	if config.Epoch != 0 {
-		snowflake.Epoch = config.Epoch
+	layouts := map[string]xsnowflake.Layout{
+		"": config.layout(LayoutConfig{}),
+	}
+	for _, name := range config.Generators {
+		if name == "" {
+			return nil, fmt.Errorf("snowflake Generators err,a generator needs a name")
+		}
+		if _, ok := layouts[name]; ok {
+			return nil, fmt.Errorf("snowflake Generators:%v err,%q is listed twice", config.Generators, name)
+		}
+		layouts[name] = config.layout(config.layouts[name])
 	}
 
-	if config.NodeBits != 0 {
-		snowflake.NodeBits = config.NodeBits
+	datacenterId, err := config.datacenterId()
+	if err != nil {
+		return nil, err
 	}
 
-	if config.StepBits != 0 {
-		snowflake.StepBits = config.StepBits
+	// the node id and the datacenter id are shared by all generators, so they have to fit the smallest bits
+	var maxNodeId int64 = -1
+	for name, layout := range layouts {
+		if layout.Validate() != nil {
+			return nil, fmt.Errorf("snowflake %q DatacenterBits:%v NodeBits:%v and StepBits:%v err,sum dont 22", name, layout.DatacenterBits, layout.NodeBits, layout.StepBits)
+		}
+		if layout.DatacenterBits > 0 && (datacenterId < 0 || datacenterId > layout.MaxDatacenterId()) {
+			return nil, fmt.Errorf("snowflake %q DatacenterID:%v err,must be between 0 and %d", name, datacenterId, layout.MaxDatacenterId())
+		}
+		if maxNodeId < 0 || layout.MaxNodeId() < maxNodeId {
+			maxNodeId = layout.MaxNodeId()
+		}
 	}
 
-	if snowflake.NodeBits+snowflake.StepBits != 22 {
-		return nil, fmt.Errorf("snowflake NodeBits:%v and StepBits:%v err,sum dont 22", snowflake.NodeBits, snowflake.StepBits)
+	if config.EnableRedis && config.EnableEtcd {
+		return nil, fmt.Errorf("snowflake EnableRedis and EnableEtcd err,only one of them can assign node ids")
+	}
+
+	if config.NodeLeaseTTL <= 0 {
+		return nil, fmt.Errorf("snowflake NodeLeaseTTL:%v err,must be positive", config.NodeLeaseTTL)
+	}
+
+	if allocator := config.nodeAllocator(); config.EnableNodeLeases && allocator != AllocatorRedis && allocator != AllocatorEtcd {
+		return nil, fmt.Errorf("snowflake EnableNodeLeases err,node ids are only leased to clients by the redis or etcd allocator, not by %s", allocator)
+	}
+
+	// the marks of the leases of clients are read by the instance that leases the node id next, so they are kept next to the pool
+	if allocator := config.nodeAllocator(); config.EnableNodeLeases && config.HighWaterMarkStore != "" && config.HighWaterMarkStore != allocator {
+		return nil, fmt.Errorf("snowflake HighWaterMarkStore:%v err,node ids leased to clients need the high-water marks in %s", config.HighWaterMarkStore, allocator)
+	}
+
+	// a node id of redis or etcd moves between hosts, the next host would not read a mark left on the disk of the last one
+	if allocator := config.nodeAllocator(); config.HighWaterMarkStore == HighWaterMarkFile && (allocator == AllocatorRedis || allocator == AllocatorEtcd) {
+		return nil, fmt.Errorf("snowflake HighWaterMarkStore:%v err,node ids of the %s allocator move between hosts, keep the marks in %s", config.HighWaterMarkStore, allocator, allocator)
+	}
+
+	if config.HighWaterMarkInterval <= 0 {
+		return nil, fmt.Errorf("snowflake HighWaterMarkInterval:%v err,must be positive", config.HighWaterMarkInterval)
+	}
+
+	if config.HighWaterMarkPolicy != HighWaterMarkWait && config.HighWaterMarkPolicy != HighWaterMarkRefuse {
+		return nil, fmt.Errorf("snowflake HighWaterMarkPolicy:%v err,expect wait or refuse", config.HighWaterMarkPolicy)
+	}
+
+	if config.MaxBatchSize == 0 {
+		return nil, fmt.Errorf("snowflake MaxBatchSize err,must be positive")
+	}
+
+	if config.SegmentPrefetchThreshold <= 0 || config.SegmentPrefetchThreshold > 1 {
+		return nil, fmt.Errorf("snowflake SegmentPrefetchThreshold:%v err,must be in (0, 1]", config.SegmentPrefetchThreshold)
+	}
+
+	if config.SegmentFetchTimeout <= 0 {
+		return nil, fmt.Errorf("snowflake SegmentFetchTimeout:%v err,must be positive", config.SegmentFetchTimeout)
+	}
+
+	if config.IdempotencyTTL <= 0 {
+		return nil, fmt.Errorf("snowflake IdempotencyTTL:%v err,must be positive", config.IdempotencyTTL)
+	}
+
+	if config.IdempotencyMaxKeys <= 0 {
+		return nil, fmt.Errorf("snowflake IdempotencyMaxKeys:%v err,must be positive", config.IdempotencyMaxKeys)
+	}
+
+	namespaces, err := newNamespaces(config.Namespaces)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixes, err := newPrefixes(config.Prefixes, layouts)
+	if err != nil {
+		return nil, err
+	}
+
+	obfuscator, err := newObfuscator(config.ObfuscationKeys, config.ObfuscationKeyVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	sequences, err := newSequences(config.Sequences)
+	if err != nil {
+		return nil, err
 	}
 
 	if config.NodeID == 0 {
@@ -105,8 +311,85 @@ func (config *Config) Build() (*Uuid, error) {
 	}
 
 	return &Uuid{
-		snowflakeRw: &sync.RWMutex{},
-		nodeId:      config.NodeID,
-		enableRedis: config.EnableRedis,
+		snowflakeRw:  &sync.RWMutex{},
+		snowflakeMap: make(map[string]*xsnowflake.Generator, len(layouts)),
+		nodeId:       config.NodeID,
+		maxNodeId:    maxNodeId,
+		datacenterId: datacenterId,
+		layouts:      layouts,
+		ulid:         newUlidGenerator(),
+		ksuid:        newKsuidGenerator(),
+		namespaces:   namespaces,
+		prefixes:     prefixes,
+		obfuscator:   obfuscator,
+		sequences:    sequences,
+		config:       config,
+		stop:         make(chan struct{}),
 	}, nil
 }
+
+// layout fills the fields the layout of a generator leaves out with the ones of config, then with the defaults
+func (config *Config) layout(layout LayoutConfig) xsnowflake.Layout {
+	result := xsnowflake.DefaultLayout()
+	for _, l := range []LayoutConfig{{config.Epoch, config.DatacenterBits, config.NodeBits, config.StepBits}, layout} {
+		if l.Epoch != 0 {
+			result.Epoch = l.Epoch
+		}
+		if l.DatacenterBits != 0 {
+			result.DatacenterBits = l.DatacenterBits
+		}
+		if l.NodeBits != 0 {
+			result.NodeBits = l.NodeBits
+		}
+		if l.StepBits != 0 {
+			result.StepBits = l.StepBits
+		}
+	}
+	return result
+}
+
+// datacenterId the datacenter id of the region and zone the instance runs in
+func (config *Config) datacenterId() (int64, error) {
+	if len(config.Datacenters) == 0 {
+		return config.DatacenterID, nil
+	}
+
+	if key := config.datacenter(); key != "" {
+		return config.Datacenters[key], nil
+	}
+	return 0, fmt.Errorf("snowflake Datacenters err,no datacenter id for region:%q zone:%q", pkg.AppRegion(), pkg.AppZone())
+}
+
+// datacenter the key of Datacenters the instance runs in, the zone of the region takes precedence over the region
+func (config *Config) datacenter() string {
+	for _, key := range []string{pkg.AppRegion() + "/" + pkg.AppZone(), pkg.AppRegion()} {
+		if _, ok := config.Datacenters[key]; ok {
+			return key
+		}
+	}
+	return ""
+}
+
+// datacenterNames the keys of Datacenters by datacenter id, the shortest key if several share an id
+func (config *Config) datacenterNames() map[int64]string {
+	names := make(map[int64]string, len(config.Datacenters))
+	for key, id := range config.Datacenters {
+		if name, ok := names[id]; !ok || len(key) < len(name) || len(key) == len(name) && key < name {
+			names[id] = key
+		}
+	}
+	return names
+}
+
+// nodeAllocator the name of the node id allocator, enableRedis and enableEtcd predate nodeAllocator
+func (config *Config) nodeAllocator() string {
+	switch {
+	case config.NodeAllocator != "":
+		return config.NodeAllocator
+	case config.EnableRedis:
+		return AllocatorRedis
+	case config.EnableEtcd:
+		return AllocatorEtcd
+	}
+	return AllocatorStatic
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	uuidv1 "github.com/douyu/jupiter-examples/uuid/gen/api/go/uuid/v1"
+	"github.com/douyu/jupiter/pkg/util/xerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// grpcCodes the grpc code every ErrorCode of the service is answered with
+var grpcCodes = map[uuidv1.ErrorCode]codes.Code{}
+
+// The ecode of every error of the service is its ErrorCode in uuid.proto
+var (
+	// ErrNodeLeaseExpired the node id lease could not be renewed in time, ids generated now could collide with another node
+	ErrNodeLeaseExpired = newError(uuidv1.ErrorCode_ERROR_CODE_NODE_LEASE_EXPIRED, codes.Unavailable, "node id lease expired")
+	// ErrNodeIdExhausted every node id allowed by NodeBits is held by a live instance
+	ErrNodeIdExhausted = newError(uuidv1.ErrorCode_ERROR_CODE_NODE_ID_EXHAUSTED, codes.ResourceExhausted, "node id pool exhausted")
+	// ErrClockRollback the clock is behind the last id issued and the clock rollback policy refused to mint
+	ErrClockRollback = newError(uuidv1.ErrorCode_ERROR_CODE_CLOCK_ROLLBACK, codes.Aborted, "clock moved backwards")
+	// ErrClockBehindHighWaterMark the clock is not past the last id a previous run of the node id may have issued
+	ErrClockBehindHighWaterMark = newError(uuidv1.ErrorCode_ERROR_CODE_CLOCK_BEHIND_HIGH_WATER_MARK, codes.FailedPrecondition, "clock is behind the high-water mark")
+	// ErrUnknownGenerator the request names a generator that is not in generators of [jupiter.server.uuid]
+	ErrUnknownGenerator = newError(uuidv1.ErrorCode_ERROR_CODE_UNKNOWN_GENERATOR, codes.NotFound, "unknown generator")
+	// ErrGenerateFailed the random source failed or the monotonic ulid ran out of its millisecond
+	ErrGenerateFailed = newError(uuidv1.ErrorCode_ERROR_CODE_GENERATE_FAILED, codes.Internal, "generate failed")
+	// ErrUnknownNamespace the namespace is neither an alias of Namespaces nor a uuid
+	ErrUnknownNamespace = newError(uuidv1.ErrorCode_ERROR_CODE_UNKNOWN_NAMESPACE, codes.InvalidArgument, "unknown namespace")
+	// ErrInvalidVersion the version is not one of the name-based uuid versions 3 and 5
+	ErrInvalidVersion = newError(uuidv1.ErrorCode_ERROR_CODE_INVALID_UUID_VERSION, codes.InvalidArgument, "invalid uuid version")
+	// ErrInvalidBatchCount the batch is empty or larger than MaxBatchSize
+	ErrInvalidBatchCount = newError(uuidv1.ErrorCode_ERROR_CODE_INVALID_BATCH_COUNT, codes.InvalidArgument, "invalid batch count")
+	// ErrUnknownFormat the format is not one of the encodings of pkg/snowflake
+	ErrUnknownFormat = newError(uuidv1.ErrorCode_ERROR_CODE_UNKNOWN_FORMAT, codes.InvalidArgument, "unknown format")
+	// ErrInvalidSnowflake the id could not have been minted under the epoch and bits of this server
+	ErrInvalidSnowflake = newError(uuidv1.ErrorCode_ERROR_CODE_INVALID_SNOWFLAKE, codes.InvalidArgument, "invalid snowflake id")
+	// ErrInvalidTimeWindow the window is reversed or outside of the timestamps the layout can hold
+	ErrInvalidTimeWindow = newError(uuidv1.ErrorCode_ERROR_CODE_INVALID_TIME_WINDOW, codes.InvalidArgument, "invalid time window")
+	// ErrInvalidPublicId the public id is not a prefix, an underscore and the base62 body of a uuid of its kind
+	ErrInvalidPublicId = newError(uuidv1.ErrorCode_ERROR_CODE_INVALID_PUBLIC_ID, codes.InvalidArgument, "invalid public id")
+	// ErrInvalidObfuscatedId the obfuscated id is not a key version and a base62 id
+	ErrInvalidObfuscatedId = newError(uuidv1.ErrorCode_ERROR_CODE_INVALID_OBFUSCATED_ID, codes.InvalidArgument, "invalid obfuscated id")
+	// ErrObfuscationDisabled there are no ObfuscationKeys
+	ErrObfuscationDisabled = newError(uuidv1.ErrorCode_ERROR_CODE_OBFUSCATION_DISABLED, codes.FailedPrecondition, "obfuscation is disabled, there are no obfuscation keys")
+	// ErrSegmentDisabled EnableSegment is off
+	ErrSegmentDisabled = newError(uuidv1.ErrorCode_ERROR_CODE_SEGMENT_DISABLED, codes.FailedPrecondition, "segment ids are disabled")
+	// ErrUnknownBizTag the biz tag has no row in leaf_alloc
+	ErrUnknownBizTag = newError(uuidv1.ErrorCode_ERROR_CODE_UNKNOWN_BIZ_TAG, codes.NotFound, "unknown biz tag")
+	// ErrSegmentUnavailable the current segment is used up and the database did not hand out the next one in time
+	ErrSegmentUnavailable = newError(uuidv1.ErrorCode_ERROR_CODE_SEGMENT_UNAVAILABLE, codes.Unavailable, "segment unavailable")
+	// ErrUnknownSequence the sequence key is not in Sequences
+	ErrUnknownSequence = newError(uuidv1.ErrorCode_ERROR_CODE_UNKNOWN_SEQUENCE, codes.NotFound, "unknown sequence")
+	// ErrSequenceUnavailable the range of the sequence is used up and redis did not reserve the next one
+	ErrSequenceUnavailable = newError(uuidv1.ErrorCode_ERROR_CODE_SEQUENCE_UNAVAILABLE, codes.Unavailable, "sequence unavailable")
+	// ErrIdempotencyDisabled the request has an idempotency key but there is no IdempotencyStore
+	ErrIdempotencyDisabled = newError(uuidv1.ErrorCode_ERROR_CODE_IDEMPOTENCY_DISABLED, codes.FailedPrecondition, "idempotency keys are disabled, there is no idempotency store")
+	// ErrInvalidIdempotencyKey the idempotency key is too long
+	ErrInvalidIdempotencyKey = newError(uuidv1.ErrorCode_ERROR_CODE_INVALID_IDEMPOTENCY_KEY, codes.InvalidArgument, "invalid idempotency key")
+	// ErrIdempotencyStoreFull the memory store holds IdempotencyMaxKeys keys that have not expired yet
+	ErrIdempotencyStoreFull = newError(uuidv1.ErrorCode_ERROR_CODE_IDEMPOTENCY_STORE_FULL, codes.ResourceExhausted, "idempotency store is full")
+	// ErrIdempotencyUnavailable the idempotency store failed, the ids are not handed out as a retry could not get them back
+	ErrIdempotencyUnavailable = newError(uuidv1.ErrorCode_ERROR_CODE_IDEMPOTENCY_UNAVAILABLE, codes.Unavailable, "idempotency store unavailable")
+	// ErrNodeLeasesDisabled EnableNodeLeases is off
+	ErrNodeLeasesDisabled = newError(uuidv1.ErrorCode_ERROR_CODE_NODE_LEASES_DISABLED, codes.FailedPrecondition, "node id leases are disabled")
+	// ErrInvalidLeaseOwner the owner of the lease is too long
+	ErrInvalidLeaseOwner = newError(uuidv1.ErrorCode_ERROR_CODE_INVALID_LEASE_OWNER, codes.InvalidArgument, "invalid lease owner")
+	// ErrNodeLeaseLost the lease of the client expired, was released or never existed, the client must stop generating with its node id
+	ErrNodeLeaseLost = newError(uuidv1.ErrorCode_ERROR_CODE_NODE_LEASE_LOST, codes.NotFound, "node id lease lost")
+	// ErrNodeLeaseUnavailable redis or etcd failed to lease, renew or list node ids
+	ErrNodeLeaseUnavailable = newError(uuidv1.ErrorCode_ERROR_CODE_NODE_LEASE_UNAVAILABLE, codes.Unavailable, "node id leases unavailable")
+	// ErrUnknownPrefix the prefix of the public id is not in Prefixes
+	ErrUnknownPrefix = newError(uuidv1.ErrorCode_ERROR_CODE_UNKNOWN_PUBLIC_ID_PREFIX, codes.NotFound, "unknown public id prefix")
+	// ErrBadChecksum the check character of the public id does not match, it was most likely mistyped
+	ErrBadChecksum = newError(uuidv1.ErrorCode_ERROR_CODE_PUBLIC_ID_CHECKSUM_MISMATCH, codes.InvalidArgument, "public id checksum mismatch")
+	// ErrUnknownKeyVersion the key version of the obfuscated id is not in ObfuscationKeys, its key may have been dropped
+	ErrUnknownKeyVersion = newError(uuidv1.ErrorCode_ERROR_CODE_UNKNOWN_OBFUSCATION_KEY_VERSION, codes.NotFound, "unknown obfuscation key version")
+	// ErrIdempotencyKeyReused the idempotency key was used for a request asking for other ids
+	ErrIdempotencyKeyReused = newError(uuidv1.ErrorCode_ERROR_CODE_IDEMPOTENCY_KEY_REUSED, codes.AlreadyExists, "idempotency key reused for a different request")
+)
+
+func errNodeIdExhausted(maxNodeId int64) error {
+	return ErrNodeIdExhausted.WithMsg(fmt.Sprintf("node id pool exhausted, all %d node ids are leased by live instances", maxNodeId))
+}
+
+// newError an error of code, answered with grpcCode
+func newError(code uuidv1.ErrorCode, grpcCode codes.Code, msg string) *xerror.Err {
+	grpcCodes[code] = grpcCode
+	return xerror.New(codes.Code(code), msg)
+}
+
+// ErrorCode the ErrorCode of err and the grpc code it is answered with. An error that is not one of the service
+// is ERROR_CODE_INTERNAL, answered with the code of a canceled context or of the grpc status it carries, Internal otherwise
+func ErrorCode(err error) (uuidv1.ErrorCode, codes.Code) {
+	var e *xerror.Err
+	if errors.As(err, &e) {
+		if grpcCode, ok := grpcCodes[uuidv1.ErrorCode(e.GetEcode())]; ok {
+			return uuidv1.ErrorCode(e.GetEcode()), grpcCode
+		}
+		// the ecode of an xerror of no ErrorCode is no grpc code
+		return uuidv1.ErrorCode_ERROR_CODE_INTERNAL, codes.Internal
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return uuidv1.ErrorCode_ERROR_CODE_INTERNAL, codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return uuidv1.ErrorCode_ERROR_CODE_INTERNAL, codes.DeadlineExceeded
+	}
+	if s, ok := status.FromError(err); ok {
+		return uuidv1.ErrorCode_ERROR_CODE_INTERNAL, s.Code()
+	}
+	return uuidv1.ErrorCode_ERROR_CODE_INTERNAL, codes.Internal
+}
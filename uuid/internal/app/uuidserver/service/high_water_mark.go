@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	etcdCli "github.com/douyu/jupiter-examples/uuid/internal/pkg/etcd"
+	redisCli "github.com/douyu/jupiter-examples/uuid/internal/pkg/redis"
+)
+
+const (
+	// HighWaterMarkFile keeps the mark in a file per node id under HighWaterMarkDir
+	HighWaterMarkFile = "file"
+	// HighWaterMarkRedis keeps the mark next to the node id leases in redis
+	HighWaterMarkRedis = "redis"
+	// HighWaterMarkEtcd keeps the mark next to the node id claims in etcd
+	HighWaterMarkEtcd = "etcd"
+
+	// HighWaterMarkWait NewUuidService waits until the clock is past the mark
+	HighWaterMarkWait = "wait"
+	// HighWaterMarkRefuse NewUuidService fails while the clock is not past the mark
+	HighWaterMarkRefuse = "refuse"
+)
+
+// HighWaterMarkStore keeps the time of the last id of a node id across restarts
+type HighWaterMarkStore interface {
+	// Load the mark of nodeId, the zero time if there is none
+	Load(ctx context.Context, nodeId int64) (time.Time, error)
+	// Save the mark of nodeId
+	Save(ctx context.Context, nodeId int64, mark time.Time) error
+}
+
+// newHighWaterMarkStore the store of config.HighWaterMarkStore, nil if marks are not kept
+func newHighWaterMarkStore(config *Config, options Options) (HighWaterMarkStore, error) {
+	switch config.HighWaterMarkStore {
+	case "":
+		return nil, nil
+	case HighWaterMarkFile:
+		return &fileHighWaterMarkStore{dir: config.HighWaterMarkDir}, nil
+	case HighWaterMarkRedis:
+		return &redisHighWaterMarkStore{redis: options.Redis}, nil
+	case HighWaterMarkEtcd:
+		return &etcdHighWaterMarkStore{etcd: options.Etcd}, nil
+	}
+	return nil, fmt.Errorf("unknown high-water mark store %q, expect one of file, redis, etcd", config.HighWaterMarkStore)
+}
+
+type fileHighWaterMarkStore struct {
+	dir string
+}
+
+func (s *fileHighWaterMarkStore) Load(ctx context.Context, nodeId int64) (time.Time, error) {
+	content, err := os.ReadFile(s.path(nodeId))
+	if errors.Is(err, os.ErrNotExist) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	mark, err := strconv.ParseInt(strings.TrimSpace(string(content)), 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("high-water mark file %s is corrupt: %w", s.path(nodeId), err)
+	}
+	return time.UnixMilli(mark), nil
+}
+
+func (s *fileHighWaterMarkStore) Save(ctx context.Context, nodeId int64, mark time.Time) error {
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return err
+	}
+
+	// rename over the old mark, so a crash never leaves a half written one
+	tmp := s.path(nodeId) + ".tmp"
+	if err := os.WriteFile(tmp, []byte(strconv.FormatInt(mark.UnixMilli(), 10)), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path(nodeId))
+}
+
+func (s *fileHighWaterMarkStore) path(nodeId int64) string {
+	return filepath.Join(s.dir, fmt.Sprintf("node-%d.hwm", nodeId))
+}
+
+type redisHighWaterMarkStore struct {
+	redis redisCli.RedisInterface
+}
+
+func (s *redisHighWaterMarkStore) Load(ctx context.Context, nodeId int64) (time.Time, error) {
+	mark, err := s.redis.GetHighWaterMark(ctx, nodeId)
+	if err != nil || mark == 0 {
+		return time.Time{}, err
+	}
+	return time.UnixMilli(mark), nil
+}
+
+func (s *redisHighWaterMarkStore) Save(ctx context.Context, nodeId int64, mark time.Time) error {
+	return s.redis.SetHighWaterMark(ctx, nodeId, mark.UnixMilli())
+}
+
+type etcdHighWaterMarkStore struct {
+	etcd etcdCli.EtcdInterface
+}
+
+func (s *etcdHighWaterMarkStore) Load(ctx context.Context, nodeId int64) (time.Time, error) {
+	mark, err := s.etcd.GetHighWaterMark(ctx, nodeId)
+	if err != nil || mark == 0 {
+		return time.Time{}, err
+	}
+	return time.UnixMilli(mark), nil
+}
+
+func (s *etcdHighWaterMarkStore) Save(ctx context.Context, nodeId int64, mark time.Time) error {
+	return s.etcd.SetHighWaterMark(ctx, nodeId, mark.UnixMilli())
+}
@@ -0,0 +1,204 @@
+package service
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	redisCli "github.com/douyu/jupiter-examples/uuid/internal/pkg/redis"
+	"github.com/douyu/jupiter/pkg/xlog"
+	"go.uber.org/zap"
+)
+
+const (
+	// IdempotencyMemory keeps the idempotency keys in the memory of the instance, retries have to reach the same instance
+	IdempotencyMemory = "memory"
+	// IdempotencyRedis keeps the idempotency keys in redis, shared by all instances
+	IdempotencyRedis = "redis"
+
+	// maxIdempotencyKeySize the longest idempotency key a client may send
+	maxIdempotencyKeySize = 256
+)
+
+// errIdempotencyStoreFull the memory store holds IdempotencyMaxKeys keys that have not expired yet
+var errIdempotencyStoreFull = errors.New("idempotency store is full")
+
+// IdempotentRecord the ids handed out for an idempotency key
+type IdempotentRecord struct {
+	// Request what was asked for with the key, a retry has to ask for the same
+	Request string   `json:"request"`
+	Uuids   []string `json:"uuids"`
+	Ids     []uint64 `json:"ids"`
+}
+
+// IdempotencyStore remembers the ids handed out for an idempotency key. A store must never drop a key before its
+// ttl is over, it refuses new keys instead, or a retry would silently be handed different ids
+type IdempotencyStore interface {
+	// Get the record of key, nil if there is none
+	Get(ctx context.Context, key string) (*IdempotentRecord, error)
+	// Claim stores record for key for ttl unless key has a record already, it returns the record of key either way
+	Claim(ctx context.Context, key string, record *IdempotentRecord, ttl time.Duration) (*IdempotentRecord, error)
+}
+
+// newIdempotencyStore the store of config.IdempotencyStore, nil if idempotency keys are not accepted
+func newIdempotencyStore(ctx context.Context, config *Config, options Options) (IdempotencyStore, error) {
+	switch config.IdempotencyStore {
+	case "":
+		return nil, nil
+	case IdempotencyMemory:
+		return newMemoryIdempotencyStore(config.IdempotencyMaxKeys), nil
+	case IdempotencyRedis:
+		// every other policy lets redis evict keys before their ttl is over
+		policy, err := options.Redis.MaxMemoryPolicy(ctx)
+		if err != nil {
+			xlog.Warn("idempotency store could not check the maxmemory-policy of redis, it has to be noeviction", zap.Error(err))
+		} else if policy != "noeviction" {
+			return nil, fmt.Errorf("idempotency store redis err,maxmemory-policy is %q, it has to be noeviction", policy)
+		}
+		return &redisIdempotencyStore{redis: options.Redis}, nil
+	}
+	return nil, fmt.Errorf("unknown idempotency store %q, expect one of memory, redis", config.IdempotencyStore)
+}
+
+// idempotent hands out the ids recorded for key if request was made with it before, the ids of generate otherwise
+func (u *Uuid) idempotent(ctx context.Context, key string, request string, generate func() (*IdempotentRecord, error)) (*IdempotentRecord, error) {
+	if key == "" {
+		return generate()
+	}
+
+	if u.idempotency == nil {
+		return nil, ErrIdempotencyDisabled
+	}
+	if len(key) > maxIdempotencyKeySize {
+		return nil, ErrInvalidIdempotencyKey.WithMsg(fmt.Sprintf("idempotency key is longer than %d bytes", maxIdempotencyKeySize))
+	}
+
+	record, err := u.idempotency.Get(ctx, key)
+	if err != nil {
+		return nil, ErrIdempotencyUnavailable.WithMsg(err.Error())
+	}
+
+	if record == nil {
+		generated, err := generate()
+		if err != nil {
+			return nil, err
+		}
+		generated.Request = request
+
+		// a concurrent retry may have claimed the key in the meantime, then its ids are the ones handed out
+		record, err = u.idempotency.Claim(ctx, key, generated, u.config.IdempotencyTTL)
+		if errors.Is(err, errIdempotencyStoreFull) {
+			return nil, ErrIdempotencyStoreFull
+		}
+		if err != nil {
+			return nil, ErrIdempotencyUnavailable.WithMsg(err.Error())
+		}
+	}
+
+	if record.Request != request {
+		return nil, ErrIdempotencyKeyReused.WithMsg(fmt.Sprintf("idempotency key %q was used for %s, not for %s", key, record.Request, request))
+	}
+	return record, nil
+}
+
+// memoryIdempotencyStore keeps up to maxKeys keys, all keys share the ttl, so they expire in the order they were claimed
+type memoryIdempotencyStore struct {
+	maxKeys int
+
+	mu      sync.Mutex
+	records map[string]*list.Element
+	// expiries the memoryIdempotencyEntry of the keys, the next to expire first
+	expiries *list.List
+}
+
+type memoryIdempotencyEntry struct {
+	key      string
+	record   *IdempotentRecord
+	expireAt time.Time
+}
+
+func newMemoryIdempotencyStore(maxKeys int) *memoryIdempotencyStore {
+	return &memoryIdempotencyStore{
+		maxKeys:  maxKeys,
+		records:  make(map[string]*list.Element),
+		expiries: list.New(),
+	}
+}
+
+func (s *memoryIdempotencyStore) Get(ctx context.Context, key string) (*IdempotentRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.expire(time.Now())
+	if element, ok := s.records[key]; ok {
+		return element.Value.(*memoryIdempotencyEntry).record, nil
+	}
+	return nil, nil
+}
+
+func (s *memoryIdempotencyStore) Claim(ctx context.Context, key string, record *IdempotentRecord, ttl time.Duration) (*IdempotentRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.expire(now)
+	if element, ok := s.records[key]; ok {
+		return element.Value.(*memoryIdempotencyEntry).record, nil
+	}
+
+	if len(s.records) >= s.maxKeys {
+		return nil, errIdempotencyStoreFull
+	}
+
+	s.records[key] = s.expiries.PushBack(&memoryIdempotencyEntry{key: key, record: record, expireAt: now.Add(ttl)})
+	return record, nil
+}
+
+// expire drops the keys whose ttl is over
+func (s *memoryIdempotencyStore) expire(now time.Time) {
+	for element := s.expiries.Front(); element != nil; element = s.expiries.Front() {
+		entry := element.Value.(*memoryIdempotencyEntry)
+		if entry.expireAt.After(now) {
+			return
+		}
+		s.expiries.Remove(element)
+		delete(s.records, entry.key)
+	}
+}
+
+type redisIdempotencyStore struct {
+	redis redisCli.RedisInterface
+}
+
+func (s *redisIdempotencyStore) Get(ctx context.Context, key string) (*IdempotentRecord, error) {
+	content, err := s.redis.GetIdempotencyKey(ctx, key)
+	if err != nil || content == nil {
+		return nil, err
+	}
+	return decodeIdempotentRecord(key, content)
+}
+
+func (s *redisIdempotencyStore) Claim(ctx context.Context, key string, record *IdempotentRecord, ttl time.Duration) (*IdempotentRecord, error) {
+	content, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err = s.redis.ClaimIdempotencyKey(ctx, key, content, ttl)
+	if err != nil {
+		return nil, err
+	}
+	return decodeIdempotentRecord(key, content)
+}
+
+func decodeIdempotentRecord(key string, content []byte) (*IdempotentRecord, error) {
+	var record IdempotentRecord
+	if err := json.Unmarshal(content, &record); err != nil {
+		return nil, fmt.Errorf("idempotency key %q has a malformed record: %v", key, err)
+	}
+	return &record, nil
+}
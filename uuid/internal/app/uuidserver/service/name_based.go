@@ -0,0 +1,57 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// builtinNamespaces the namespaces of RFC 4122, namespaces of the config may add to them
+var builtinNamespaces = map[string]uuid.UUID{
+	"dns":  uuid.NameSpaceDNS,
+	"url":  uuid.NameSpaceURL,
+	"oid":  uuid.NameSpaceOID,
+	"x500": uuid.NameSpaceX500,
+}
+
+// newNamespaces the builtin namespaces plus the ones of config by alias
+func newNamespaces(aliases map[string]string) (map[string]uuid.UUID, error) {
+	namespaces := make(map[string]uuid.UUID, len(builtinNamespaces)+len(aliases))
+	for alias, namespace := range builtinNamespaces {
+		namespaces[alias] = namespace
+	}
+
+	for alias, namespace := range aliases {
+		id, err := uuid.Parse(namespace)
+		if err != nil {
+			return nil, fmt.Errorf("snowflake Namespaces err,%q of alias %q is not a uuid", namespace, alias)
+		}
+		namespaces[alias] = id
+	}
+
+	return namespaces, nil
+}
+
+// namespace the namespace of an alias, or the namespace itself if it is a uuid
+func (u *Uuid) namespace(namespace string) (uuid.UUID, error) {
+	if id, ok := u.namespaces[namespace]; ok {
+		return id, nil
+	}
+
+	id, err := uuid.Parse(namespace)
+	if err != nil {
+		return uuid.Nil, ErrUnknownNamespace.WithMsg(fmt.Sprintf("namespace %q is neither an alias nor a uuid", namespace))
+	}
+	return id, nil
+}
+
+// nameBased the function that maps a name in a namespace to a uuid of version
+func nameBased(version uint32) (func(space uuid.UUID, data []byte) uuid.UUID, error) {
+	switch version {
+	case 0, 5:
+		return uuid.NewSHA1, nil
+	case 3:
+		return uuid.NewMD5, nil
+	}
+	return nil, ErrInvalidVersion.WithMsg(fmt.Sprintf("version %d is not a name-based uuid version, expect 3 or 5", version))
+}
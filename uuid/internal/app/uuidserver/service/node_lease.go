@@ -0,0 +1,27 @@
+package service
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/google/uuid"
+)
+
+// NodeLease is a node id claimed from a shared backend, it stays ours until it expires or is released
+type NodeLease interface {
+	// NodeId the claimed node id
+	NodeId() int64
+	// Valid reports whether the node id is still known to be held
+	Valid() bool
+	// Lost is closed once the node id is known to be gone, such as after an outage of the backend longer than the lease.
+	// Release does not close it
+	Lost() <-chan struct{}
+	// Release gives the node id back, it is safe to call more than once
+	Release()
+}
+
+// newLeaseOwner identifies this process as the holder of a lease
+func newLeaseOwner() string {
+	hostname, _ := os.Hostname()
+	return fmt.Sprintf("%s-%d-%s", hostname, os.Getpid(), uuid.NewString())
+}
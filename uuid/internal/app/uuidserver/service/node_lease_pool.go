@@ -0,0 +1,394 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	uuidv1 "github.com/douyu/jupiter-examples/uuid/gen/api/go/uuid/v1"
+	etcdCli "github.com/douyu/jupiter-examples/uuid/internal/pkg/etcd"
+	redisCli "github.com/douyu/jupiter-examples/uuid/internal/pkg/redis"
+	"github.com/douyu/jupiter/pkg/core/metric"
+	"github.com/douyu/jupiter/pkg/xlog"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// clientLeaseOwnerPrefix starts the owner of every node id leased to a client, the owners of servers never do
+	clientLeaseOwnerPrefix = "client/"
+	// maxLeaseOwnerSize the longest client name a lease may carry
+	maxLeaseOwnerSize = 128
+)
+
+var (
+	// nodeLeaseCounter counts the node id leases of clients by operation and result
+	nodeLeaseCounter = metric.NewCounterVec("uuid_node_lease_total", []string{"op", "result"})
+
+	// errNodeLeasePoolExhausted every node id of the pool is held by a server or a client
+	errNodeLeasePoolExhausted = errors.New("node id pool exhausted")
+	// errNodeLeaseLost the lease expired, was released or is held by another owner
+	errNodeLeaseLost = errors.New("node id lease lost")
+)
+
+// LeasedNode a live lease of the node id pool
+type LeasedNode struct {
+	NodeId int64
+	Owner  string
+	// TTL how long the lease has left
+	TTL time.Duration
+}
+
+// NodeLeasePool leases the node ids of the pool the node id of the instance is taken from to clients.
+// A node id is only leased again once its lease is over, whoever held it
+type NodeLeasePool interface {
+	// Lease the lowest free node id in [1, maxNodeId] to owner, and how long the lease lasts
+	Lease(ctx context.Context, maxNodeId int64, owner string) (int64, time.Duration, error)
+	// Renew the lease of nodeId held by owner, and how long it lasts from now
+	Renew(ctx context.Context, nodeId int64, owner string) (time.Duration, error)
+	// Release the lease of nodeId if owner still holds it
+	Release(ctx context.Context, nodeId int64, owner string) error
+	// List the live leases of the node ids in [1, maxNodeId], in the order of the node ids
+	List(ctx context.Context, maxNodeId int64) ([]LeasedNode, error)
+}
+
+// newNodeLeasePool the pool of the node id allocator, nil unless EnableNodeLeases is set
+func newNodeLeasePool(config *Config, options Options) NodeLeasePool {
+	if !config.EnableNodeLeases {
+		return nil
+	}
+
+	switch config.nodeAllocator() {
+	case AllocatorRedis:
+		return &redisNodeLeasePool{redis: options.Redis, ttl: config.NodeLeaseTTL}
+	case AllocatorEtcd:
+		return &etcdNodeLeasePool{etcd: options.Etcd, ttl: config.NodeLeaseTTL}
+	}
+	// Build refuses EnableNodeLeases with any other allocator
+	return nil
+}
+
+type redisNodeLeasePool struct {
+	redis redisCli.RedisInterface
+	ttl   time.Duration
+}
+
+func (p *redisNodeLeasePool) Lease(ctx context.Context, maxNodeId int64, owner string) (int64, time.Duration, error) {
+	nodeId, err := p.redis.AcquireNodeId(ctx, maxNodeId, owner, p.ttl)
+	if errors.Is(err, redisCli.ErrNodeIdExhausted) {
+		return 0, 0, errNodeLeasePoolExhausted
+	}
+	return nodeId, p.ttl, err
+}
+
+func (p *redisNodeLeasePool) Renew(ctx context.Context, nodeId int64, owner string) (time.Duration, error) {
+	err := p.redis.RenewNodeId(ctx, nodeId, owner, p.ttl)
+	if errors.Is(err, redisCli.ErrNodeLeaseLost) {
+		return 0, errNodeLeaseLost
+	}
+	return p.ttl, err
+}
+
+func (p *redisNodeLeasePool) Release(ctx context.Context, nodeId int64, owner string) error {
+	return p.redis.ReleaseNodeId(ctx, nodeId, owner)
+}
+
+func (p *redisNodeLeasePool) List(ctx context.Context, maxNodeId int64) ([]LeasedNode, error) {
+	leases, err := p.redis.ListNodeLeases(ctx, maxNodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]LeasedNode, len(leases))
+	for i, lease := range leases {
+		result[i] = LeasedNode{NodeId: lease.NodeId, Owner: lease.Owner, TTL: lease.TTL}
+	}
+	return result, nil
+}
+
+// etcdNodeLeasePool leases a node id to a client under an etcd lease of its own, the client keeps it alive
+// through RenewNodeLease. Any instance can renew it, the lease is found by the node id and its owner
+type etcdNodeLeasePool struct {
+	etcd etcdCli.EtcdInterface
+	ttl  time.Duration
+}
+
+func (p *etcdNodeLeasePool) Lease(ctx context.Context, maxNodeId int64, owner string) (int64, time.Duration, error) {
+	nodeId, _, err := p.etcd.AcquireNodeId(ctx, maxNodeId, owner, p.ttl)
+	if errors.Is(err, etcdCli.ErrNodeIdExhausted) {
+		return 0, 0, errNodeLeasePoolExhausted
+	}
+	// etcd rounds the ttl up to seconds, the client counts with the shorter one
+	return nodeId, p.ttl, err
+}
+
+func (p *etcdNodeLeasePool) Renew(ctx context.Context, nodeId int64, owner string) (time.Duration, error) {
+	ttl, err := p.etcd.RenewNodeClaim(ctx, nodeId, owner)
+	if errors.Is(err, etcdCli.ErrNodeClaimLost) {
+		return 0, errNodeLeaseLost
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if ttl > p.ttl {
+		ttl = p.ttl
+	}
+	return ttl, nil
+}
+
+func (p *etcdNodeLeasePool) Release(ctx context.Context, nodeId int64, owner string) error {
+	return p.etcd.ReleaseNodeClaim(ctx, nodeId, owner)
+}
+
+func (p *etcdNodeLeasePool) List(ctx context.Context, maxNodeId int64) ([]LeasedNode, error) {
+	claims, err := p.etcd.ListNodeClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]LeasedNode, 0, len(claims))
+	for _, claim := range claims {
+		if claim.NodeId > maxNodeId {
+			continue
+		}
+		result = append(result, LeasedNode{NodeId: claim.NodeId, Owner: claim.Owner, TTL: claim.TTL})
+	}
+	return result, nil
+}
+
+// LeaseNodeId leases a free node id of the pool to a client along with the layout of req.Generator. The client
+// generates the snowflake ids of the node id in-process until the lease is over, it has to renew it in time.
+// If marks are kept, the client is handed the high-water mark of the node id to generate after, and the mark is
+// kept at the end of the lease, so whoever holds the node id next does not repeat an id of the client
+func (u *Uuid) LeaseNodeId(ctx context.Context, req *uuidv1.LeaseNodeIdRequest) (*uuidv1.LeaseNodeIdResponse, error) {
+	if u.nodeLeases == nil {
+		return nil, ErrNodeLeasesDisabled
+	}
+
+	layout, err := u.layout(req.GetGenerator())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.GetOwner()) > maxLeaseOwnerSize {
+		return nil, ErrInvalidLeaseOwner.WithMsg(fmt.Sprintf("owner is longer than %d bytes", maxLeaseOwnerSize))
+	}
+
+	// the random suffix keeps the lease of every call apart, it is the secret to renew and release the lease with
+	owner := clientLeaseOwnerPrefix + req.GetOwner() + "/" + uuid.NewString()
+	nodeId, ttl, err := u.nodeLeases.Lease(ctx, u.maxNodeId, owner)
+	if errors.Is(err, errNodeLeasePoolExhausted) {
+		nodeLeaseCounter.Inc("lease", "exhausted")
+		return nil, errNodeIdExhausted(u.maxNodeId)
+	}
+	if err != nil {
+		nodeLeaseCounter.Inc("lease", "failed")
+		return nil, ErrNodeLeaseUnavailable.WithMsg(err.Error())
+	}
+
+	since, err := u.markNodeLease(ctx, nodeId, ttl)
+	if err != nil {
+		nodeLeaseCounter.Inc("lease", "failed")
+		if err := u.nodeLeases.Release(ctx, nodeId, owner); err != nil {
+			xlog.Warn("release node id lease of client failed", zap.Int64("nodeId", nodeId), zap.Error(err))
+		}
+		return nil, ErrNodeLeaseUnavailable.WithMsg(err.Error())
+	}
+	nodeLeaseCounter.Inc("lease", "ok")
+	xlog.Info("node id leased to client", zap.Int64("nodeId", nodeId), zap.String("owner", req.GetOwner()), zap.Duration("ttl", ttl),
+		zap.Int64("since", sinceMilli(since)))
+
+	var datacenterId int64
+	if layout.DatacenterBits > 0 {
+		datacenterId = u.datacenterId
+	}
+
+	return &uuidv1.LeaseNodeIdResponse{
+		Error: 0,
+		Msg:   "success",
+		Data: &uuidv1.LeaseNodeIdResponse_Data{
+			NodeId:         nodeId,
+			Lease:          owner,
+			Ttl:            ttl.Milliseconds(),
+			Epoch:          layout.Epoch,
+			DatacenterBits: uint32(layout.DatacenterBits),
+			NodeBits:       uint32(layout.NodeBits),
+			StepBits:       uint32(layout.StepBits),
+			DatacenterId:   datacenterId,
+			Since:          sinceMilli(since),
+		},
+	}, nil
+}
+
+// markNodeLease the high-water mark a client leasing nodeId for ttl has to generate after. The mark is raised to the
+// end of the lease, the client stops generating by then if it is not renewed. The zero time if marks are not kept
+func (u *Uuid) markNodeLease(ctx context.Context, nodeId int64, ttl time.Duration) (time.Time, error) {
+	if u.highWaterMark == nil {
+		return time.Time{}, nil
+	}
+
+	mark, err := u.highWaterMark.Load(ctx, nodeId)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("load high-water mark of node id %d err:%v", nodeId, err)
+	}
+
+	end := time.Now().Add(ttl)
+	if end.After(mark) {
+		if err := u.highWaterMark.Save(ctx, nodeId, end); err != nil {
+			return time.Time{}, fmt.Errorf("save high-water mark of node id %d err:%v", nodeId, err)
+		}
+	}
+
+	if mark.IsZero() {
+		return mark, nil
+	}
+	// a server saves its mark once per interval, ids of up to one interval later may have been issued after it
+	return mark.Add(u.config.HighWaterMarkInterval), nil
+}
+
+// sinceMilli the unix milliseconds of since, 0 for the zero time
+func sinceMilli(since time.Time) int64 {
+	if since.IsZero() {
+		return 0
+	}
+	return since.UnixMilli()
+}
+
+// RenewNodeLease renews the lease of a node id leased to a client, a lease that is lost stays lost,
+// the client has to stop generating and lease another node id
+func (u *Uuid) RenewNodeLease(ctx context.Context, req *uuidv1.RenewNodeLeaseRequest) (*uuidv1.RenewNodeLeaseResponse, error) {
+	if u.nodeLeases == nil {
+		return nil, ErrNodeLeasesDisabled
+	}
+
+	// only the leases of clients can be renewed here, the ones of servers are kept alive by their servers
+	if !strings.HasPrefix(req.GetLease(), clientLeaseOwnerPrefix) {
+		return nil, ErrNodeLeaseLost
+	}
+
+	ttl, err := u.nodeLeases.Renew(ctx, req.GetNodeId(), req.GetLease())
+	if errors.Is(err, errNodeLeaseLost) {
+		nodeLeaseCounter.Inc("renew", "lost")
+		return nil, ErrNodeLeaseLost
+	}
+	if err != nil {
+		nodeLeaseCounter.Inc("renew", "failed")
+		return nil, ErrNodeLeaseUnavailable.WithMsg(err.Error())
+	}
+
+	if u.highWaterMark != nil {
+		// the renewed lease ends later, so may the ids of the client
+		if err := u.highWaterMark.Save(ctx, req.GetNodeId(), time.Now().Add(ttl)); err != nil {
+			nodeLeaseCounter.Inc("renew", "failed")
+			return nil, ErrNodeLeaseUnavailable.WithMsg(fmt.Sprintf("save high-water mark of node id %d err:%v", req.GetNodeId(), err))
+		}
+	}
+	nodeLeaseCounter.Inc("renew", "ok")
+
+	return &uuidv1.RenewNodeLeaseResponse{
+		Error: 0,
+		Msg:   "success",
+		Data: &uuidv1.RenewNodeLeaseResponse_Data{
+			Ttl: ttl.Milliseconds(),
+		},
+	}, nil
+}
+
+// ReleaseNodeLease gives a node id leased to a client back to the pool, releasing a lease that is lost already succeeds.
+// The high-water mark of the node id is lowered from the end of the lease to the last id of the client, or now
+// if that is later, so the node id can be generated with right away again. A last id past the end of the lease is
+// taken as the end of it
+func (u *Uuid) ReleaseNodeLease(ctx context.Context, req *uuidv1.ReleaseNodeLeaseRequest) (*uuidv1.ReleaseNodeLeaseResponse, error) {
+	if u.nodeLeases == nil {
+		return nil, ErrNodeLeasesDisabled
+	}
+
+	if !strings.HasPrefix(req.GetLease(), clientLeaseOwnerPrefix) {
+		return nil, ErrNodeLeaseLost
+	}
+
+	if u.highWaterMark != nil {
+		// only the holder of the lease may lower the mark, a lost lease may have been handed to another client since
+		ttl, err := u.nodeLeases.Renew(ctx, req.GetNodeId(), req.GetLease())
+		if errors.Is(err, errNodeLeaseLost) {
+			nodeLeaseCounter.Inc("release", "lost")
+			return &uuidv1.ReleaseNodeLeaseResponse{
+				Error: 0,
+				Msg:   "success",
+			}, nil
+		}
+		if err != nil {
+			nodeLeaseCounter.Inc("release", "failed")
+			return nil, ErrNodeLeaseUnavailable.WithMsg(err.Error())
+		}
+
+		now := time.Now()
+		mark := now
+		if last := time.UnixMilli(req.GetLast()); last.After(mark) {
+			mark = last
+		}
+		// no id of the client is past the end of the lease it confirmed, a later last would hold the node id back for good
+		if end := now.Add(ttl); mark.After(end) {
+			xlog.Warn("node id released by client with a last id past the end of its lease", zap.Int64("nodeId", req.GetNodeId()),
+				zap.Int64("last", req.GetLast()), zap.Int64("end", end.UnixMilli()))
+			mark = end
+		}
+		// the lease is kept if the mark could not be saved, the client stopped generating before the mark saved last
+		if err := u.highWaterMark.Save(ctx, req.GetNodeId(), mark); err != nil {
+			nodeLeaseCounter.Inc("release", "failed")
+			return nil, ErrNodeLeaseUnavailable.WithMsg(fmt.Sprintf("save high-water mark of node id %d err:%v", req.GetNodeId(), err))
+		}
+	}
+
+	if err := u.nodeLeases.Release(ctx, req.GetNodeId(), req.GetLease()); err != nil {
+		nodeLeaseCounter.Inc("release", "failed")
+		return nil, ErrNodeLeaseUnavailable.WithMsg(err.Error())
+	}
+	nodeLeaseCounter.Inc("release", "ok")
+	xlog.Info("node id released by client", zap.Int64("nodeId", req.GetNodeId()))
+
+	return &uuidv1.ReleaseNodeLeaseResponse{
+		Error: 0,
+		Msg:   "success",
+	}, nil
+}
+
+// ListNodeLeases the live leases of the node id pool, the servers hold theirs next to the ones of the clients
+func (u *Uuid) ListNodeLeases(ctx context.Context, req *uuidv1.ListNodeLeasesRequest) (*uuidv1.ListNodeLeasesResponse, error) {
+	if u.nodeLeases == nil {
+		return nil, ErrNodeLeasesDisabled
+	}
+
+	leases, err := u.nodeLeases.List(ctx, u.maxNodeId)
+	if err != nil {
+		return nil, ErrNodeLeaseUnavailable.WithMsg(err.Error())
+	}
+
+	nodeId := u.NodeId()
+	result := make([]*uuidv1.ListNodeLeasesResponse_Lease, len(leases))
+	for i, lease := range leases {
+		owner, client := lease.Owner, strings.HasPrefix(lease.Owner, clientLeaseOwnerPrefix)
+		if client {
+			// the random suffix renews and releases the lease, only the name of the client is shown
+			owner = strings.TrimPrefix(owner[:strings.LastIndex(owner, "/")], clientLeaseOwnerPrefix)
+		}
+		result[i] = &uuidv1.ListNodeLeasesResponse_Lease{
+			NodeId: lease.NodeId,
+			Owner:  owner,
+			Ttl:    lease.TTL.Milliseconds(),
+			Client: client,
+			Self:   !client && lease.NodeId == nodeId,
+		}
+	}
+
+	return &uuidv1.ListNodeLeasesResponse{
+		Error: 0,
+		Msg:   "success",
+		Data: &uuidv1.ListNodeLeasesResponse_Data{
+			Leases: result,
+		},
+	}, nil
+}
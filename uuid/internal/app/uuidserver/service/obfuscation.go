@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	uuidv1 "github.com/douyu/jupiter-examples/uuid/gen/api/go/uuid/v1"
+	"github.com/douyu/jupiter-examples/uuid/pkg/obfuscate"
+	xsnowflake "github.com/douyu/jupiter-examples/uuid/pkg/snowflake"
+)
+
+// maxKeyVersion key versions are written out as a single base62 character
+const maxKeyVersion = 61
+
+// obfuscator the ciphers of ObfuscationKeys by key version
+type obfuscator struct {
+	ciphers map[int64]*obfuscate.Cipher
+	// version the key version new obfuscated ids are encoded with
+	version int64
+}
+
+// newObfuscator the ciphers of keys, nil if there are no keys
+func newObfuscator(keys map[string]string, version int64) (*obfuscator, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	o := &obfuscator{
+		ciphers: make(map[int64]*obfuscate.Cipher, len(keys)),
+		version: version,
+	}
+	for v, key := range keys {
+		keyVersion, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || keyVersion < 0 || keyVersion > maxKeyVersion {
+			return nil, fmt.Errorf("snowflake ObfuscationKeys err,key version %q must be between 0 and %d", v, maxKeyVersion)
+		}
+
+		cipher, err := obfuscate.NewCipher([]byte(key))
+		if err != nil {
+			return nil, fmt.Errorf("snowflake ObfuscationKeys err,key version %d: %w", keyVersion, err)
+		}
+		o.ciphers[keyVersion] = cipher
+	}
+
+	if _, ok := o.ciphers[version]; !ok {
+		return nil, fmt.Errorf("snowflake ObfuscationKeyVersion:%v err,there is no key of that version", version)
+	}
+
+	return o, nil
+}
+
+// encode the key version, then the obfuscated id in base62
+func (o *obfuscator) encode(id int64) (string, error) {
+	obfuscated, err := o.ciphers[o.version].Encrypt(id)
+	if err != nil {
+		return "", err
+	}
+	return xsnowflake.FormatBase62.Encode(o.version) + xsnowflake.FormatBase62.Encode(obfuscated), nil
+}
+
+// decode the id and the key version of an obfuscated id
+func (o *obfuscator) decode(obfuscated string) (int64, int64, error) {
+	if len(obfuscated) < 2 {
+		return 0, 0, ErrInvalidObfuscatedId.WithMsg(fmt.Sprintf("%q is too short", obfuscated))
+	}
+
+	version, err := xsnowflake.FormatBase62.Decode(obfuscated[:1])
+	if err != nil {
+		return 0, 0, ErrInvalidObfuscatedId.WithMsg(fmt.Sprintf("%q does not start with a key version", obfuscated))
+	}
+
+	cipher, ok := o.ciphers[version]
+	if !ok {
+		return 0, 0, ErrUnknownKeyVersion.WithMsg(fmt.Sprintf("key version %d of %q is not in ObfuscationKeys", version, obfuscated))
+	}
+
+	i, err := xsnowflake.FormatBase62.Decode(obfuscated[1:])
+	if err != nil {
+		return 0, 0, ErrInvalidObfuscatedId.WithMsg(err.Error())
+	}
+
+	id, err := cipher.Decrypt(i)
+	if err != nil {
+		return 0, 0, ErrInvalidObfuscatedId.WithMsg(err.Error())
+	}
+	return id, version, nil
+}
+
+// EncodeObfuscatedId obfuscates req.Id with the key of ObfuscationKeyVersion
+func (u *Uuid) EncodeObfuscatedId(ctx context.Context, req *uuidv1.EncodeObfuscatedIdRequest) (*uuidv1.EncodeObfuscatedIdResponse, error) {
+	if u.obfuscator == nil {
+		return nil, ErrObfuscationDisabled
+	}
+
+	if req.GetId() > math.MaxInt64 {
+		return nil, ErrInvalidSnowflake.WithMsg(fmt.Sprintf("%d does not fit 63 bits", req.GetId()))
+	}
+
+	obfuscated, err := u.obfuscator.encode(int64(req.GetId()))
+	if err != nil {
+		return nil, ErrInvalidSnowflake.WithMsg(err.Error())
+	}
+
+	return &uuidv1.EncodeObfuscatedIdResponse{
+		Error: 0,
+		Msg:   "success",
+		Data: &uuidv1.EncodeObfuscatedIdResponse_Data{
+			ObfuscatedId: obfuscated,
+			KeyVersion:   uint32(u.obfuscator.version),
+		},
+	}, nil
+}
+
+// DecodeObfuscatedId recovers the id of req.ObfuscatedId, ids of retired key versions decode as long as their key is configured
+func (u *Uuid) DecodeObfuscatedId(ctx context.Context, req *uuidv1.DecodeObfuscatedIdRequest) (*uuidv1.DecodeObfuscatedIdResponse, error) {
+	if u.obfuscator == nil {
+		return nil, ErrObfuscationDisabled
+	}
+
+	id, version, err := u.obfuscator.decode(req.GetObfuscatedId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &uuidv1.DecodeObfuscatedIdResponse{
+		Error: 0,
+		Msg:   "success",
+		Data: &uuidv1.DecodeObfuscatedIdResponse_Data{
+			Id:         uint64(id),
+			Uuid:       strconv.FormatInt(id, 10),
+			KeyVersion: uint32(version),
+		},
+	}, nil
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+
+	uuidv1 "github.com/douyu/jupiter-examples/uuid/gen/api/go/uuid/v1"
+	"github.com/douyu/jupiter-examples/uuid/pkg/publicid"
+	xsnowflake "github.com/douyu/jupiter-examples/uuid/pkg/snowflake"
+	"github.com/douyu/jupiter/pkg/util/xerror"
+	"github.com/google/uuid"
+)
+
+const (
+	// PublicIdSnowflake public ids carrying a snowflake uuid of the generator of the prefix
+	PublicIdSnowflake = "snowflake"
+	// PublicIdUuidV7 public ids carrying a uuid v7
+	PublicIdUuidV7 = "uuid_v7"
+)
+
+// PrefixConfig what the public ids of a prefix carry
+type PrefixConfig struct {
+	// Kind snowflake or uuid_v7, snowflake by default
+	Kind string
+	// Generator the generator of the snowflake uuids, the one of [jupiter.server.uuid] if empty
+	Generator string
+}
+
+// newPrefixes checks the prefixes of config against the generators of the layouts
+func newPrefixes(prefixes map[string]PrefixConfig, layouts map[string]xsnowflake.Layout) (map[string]PrefixConfig, error) {
+	result := make(map[string]PrefixConfig, len(prefixes))
+	for prefix, config := range prefixes {
+		if !publicid.ValidPrefix(prefix) {
+			return nil, fmt.Errorf("snowflake Prefixes err,%q must be 1 to 16 lower case letters and digits starting with a letter", prefix)
+		}
+
+		switch config.Kind {
+		case "":
+			config.Kind = PublicIdSnowflake
+		case PublicIdSnowflake, PublicIdUuidV7:
+		default:
+			return nil, fmt.Errorf("snowflake Prefixes err,kind %q of %q is neither snowflake nor uuid_v7", config.Kind, prefix)
+		}
+
+		if _, ok := layouts[config.Generator]; !ok && config.Kind == PublicIdSnowflake {
+			return nil, fmt.Errorf("snowflake Prefixes err,generator %q of %q is not in Generators", config.Generator, prefix)
+		}
+
+		result[prefix] = config
+	}
+	return result, nil
+}
+
+// GetPublicId a public id of req.Prefix carrying a new uuid of the kind the prefix is registered with
+func (u *Uuid) GetPublicId(ctx context.Context, req *uuidv1.GetPublicIdRequest) (*uuidv1.GetPublicIdResponse, error) {
+	config, ok := u.prefixes[req.GetPrefix()]
+	if !ok {
+		return nil, ErrUnknownPrefix.WithMsg(fmt.Sprintf("prefix %q is not registered", req.GetPrefix()))
+	}
+
+	var payload []byte
+	switch config.Kind {
+	case PublicIdUuidV7:
+		id, err := uuid.NewV7()
+		if err != nil {
+			return nil, ErrGenerateFailed.WithMsg(err.Error())
+		}
+		payload = id[:]
+	default:
+		_, ids, err := u.generateSnowflakes(config.Generator, xsnowflake.FormatInt64, 1)
+		if err != nil {
+			return nil, err
+		}
+		payload = make([]byte, 8)
+		binary.BigEndian.PutUint64(payload, ids[0])
+	}
+
+	return &uuidv1.GetPublicIdResponse{
+		Error: 0,
+		Msg:   "success",
+		Data: &uuidv1.GetPublicIdResponse_Data{
+			Id: publicid.Encode(req.GetPrefix(), payload),
+		},
+	}, nil
+}
+
+// ValidatePublicId whether req.Id has a registered prefix and a matching check character, an invalid id is
+// not an error of the call, the reason tells the error ParsePublicId would fail with
+func (u *Uuid) ValidatePublicId(ctx context.Context, req *uuidv1.ValidatePublicIdRequest) (*uuidv1.ValidatePublicIdResponse, error) {
+	data := &uuidv1.ValidatePublicIdResponse_Data{Valid: true}
+	if _, _, _, err := u.decodePublicId(req.GetId()); err != nil {
+		data = &uuidv1.ValidatePublicIdResponse_Data{
+			Valid:  false,
+			Reason: uint32(xerror.Convert(err).GetEcode()),
+			Detail: xerror.Convert(err).GetMsg(),
+		}
+	}
+
+	return &uuidv1.ValidatePublicIdResponse{
+		Error: 0,
+		Msg:   "success",
+		Data:  data,
+	}, nil
+}
+
+// ParsePublicId the uuid req.Id carries and when it was generated
+func (u *Uuid) ParsePublicId(ctx context.Context, req *uuidv1.ParsePublicIdRequest) (*uuidv1.ParsePublicIdResponse, error) {
+	prefix, config, payload, err := u.decodePublicId(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	data := &uuidv1.ParsePublicIdResponse_Data{
+		Prefix: prefix,
+		Kind:   config.Kind,
+	}
+
+	switch config.Kind {
+	case PublicIdUuidV7:
+		id, _ := uuid.FromBytes(payload)
+		if id.Version() != 7 {
+			return nil, ErrInvalidPublicId.WithMsg(fmt.Sprintf("%q carries the uuid v%d %s", req.GetId(), id.Version(), id))
+		}
+		sec, nsec := id.Time().UnixTime()
+		data.Uuid = id.String()
+		data.Timestamp = sec*1000 + nsec/1e6
+	default:
+		layout, err := u.layout(config.Generator)
+		if err != nil {
+			return nil, err
+		}
+
+		id, err := layout.Parse(int64(binary.BigEndian.Uint64(payload)))
+		if err != nil {
+			return nil, ErrInvalidPublicId.WithMsg(err.Error())
+		}
+		data.Uuid = strconv.FormatInt(id.ID, 10)
+		data.Timestamp = id.Time.UnixMilli()
+		data.Generator = config.Generator
+	}
+
+	return &uuidv1.ParsePublicIdResponse{
+		Error: 0,
+		Msg:   "success",
+		Data:  data,
+	}, nil
+}
+
+// decodePublicId the prefix, its registration and the payload of a public id
+func (u *Uuid) decodePublicId(id string) (string, PrefixConfig, []byte, error) {
+	prefix, payload, err := publicid.Decode(id)
+	if errors.Is(err, publicid.ErrChecksum) {
+		return "", PrefixConfig{}, nil, ErrBadChecksum.WithMsg(err.Error())
+	}
+	if err != nil {
+		return "", PrefixConfig{}, nil, ErrInvalidPublicId.WithMsg(err.Error())
+	}
+
+	config, ok := u.prefixes[prefix]
+	if !ok {
+		return "", PrefixConfig{}, nil, ErrUnknownPrefix.WithMsg(fmt.Sprintf("prefix %q of %q is not registered", prefix, id))
+	}
+
+	size := 8
+	if config.Kind == PublicIdUuidV7 {
+		size = 16
+	}
+	if len(payload) != size {
+		return "", PrefixConfig{}, nil, ErrInvalidPublicId.WithMsg(fmt.Sprintf("%q does not carry a %s", id, config.Kind))
+	}
+
+	return prefix, config, payload, nil
+}
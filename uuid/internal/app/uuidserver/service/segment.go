@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	uuidv1 "github.com/douyu/jupiter-examples/uuid/gen/api/go/uuid/v1"
+	mysqlCli "github.com/douyu/jupiter-examples/uuid/internal/pkg/mysql"
+	"github.com/douyu/jupiter/pkg/core/metric"
+	"github.com/douyu/jupiter/pkg/xlog"
+	"go.uber.org/zap"
+)
+
+// segmentFetchCounter counts the segments fetched from leaf_alloc by biz tag and result
+var segmentFetchCounter = metric.NewCounterVec("uuid_segment_fetch_total", []string{"biz_tag", "result"})
+
+// segments the segment buffers of the biz tags asked for so far
+type segments struct {
+	mysql     mysqlCli.MysqlInterface
+	threshold float64
+	timeout   time.Duration
+
+	mu      sync.Mutex
+	buffers map[string]*segmentBuffer
+}
+
+// segmentBuffer hands out the ids of the current segment of a biz tag while the next one is fetched in the
+// background, so callers only wait on the database if it is slower than they use up a whole segment
+type segmentBuffer struct {
+	bizTag   string
+	segments *segments
+
+	mu sync.Mutex
+	// current the segment ids are handed out of, next the one fetched ahead of time
+	current, next *segment
+	// fetched is closed once the fetch in flight is done, nil while there is none
+	fetched chan struct{}
+	// err the error of the last fetch, it is reported to the callers that waited for it
+	err error
+}
+
+// segment the ids from value up to max, max excluded
+type segment struct {
+	value, max, step int64
+}
+
+func newSegments(mysql mysqlCli.MysqlInterface, threshold float64, timeout time.Duration) *segments {
+	return &segments{
+		mysql:     mysql,
+		threshold: threshold,
+		timeout:   timeout,
+		buffers:   make(map[string]*segmentBuffer),
+	}
+}
+
+// nextId the next id of bizTag
+func (s *segments) nextId(ctx context.Context, bizTag string) (int64, error) {
+	s.mu.Lock()
+	buffer, ok := s.buffers[bizTag]
+	if !ok {
+		buffer = &segmentBuffer{bizTag: bizTag, segments: s}
+		s.buffers[bizTag] = buffer
+	}
+	s.mu.Unlock()
+
+	id, err := buffer.nextId(ctx)
+	if errors.Is(err, mysqlCli.ErrUnknownBizTag) {
+		// don't keep a buffer for every tag a client made up
+		s.mu.Lock()
+		if s.buffers[bizTag] == buffer {
+			delete(s.buffers, bizTag)
+		}
+		s.mu.Unlock()
+	}
+	return id, err
+}
+
+func (b *segmentBuffer) nextId(ctx context.Context) (int64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for {
+		if current := b.current; current != nil && current.value < current.max {
+			id := current.value
+			current.value++
+
+			// fetch the next segment once the threshold of the current one is used
+			used := float64(current.step-(current.max-current.value)) / float64(current.step)
+			if b.next == nil && b.fetched == nil && used >= b.segments.threshold {
+				b.fetch()
+			}
+			return id, nil
+		}
+
+		if b.next != nil {
+			b.current, b.next = b.next, nil
+			continue
+		}
+
+		if b.fetched == nil {
+			b.fetch()
+		}
+		fetched := b.fetched
+
+		b.mu.Unlock()
+		select {
+		case <-fetched:
+		case <-ctx.Done():
+			b.mu.Lock()
+			return 0, ctx.Err()
+		}
+		b.mu.Lock()
+
+		if b.next == nil && b.err != nil {
+			return 0, b.err
+		}
+	}
+}
+
+// fetch starts fetching the next segment, b.mu has to be held
+func (b *segmentBuffer) fetch() {
+	fetched := make(chan struct{})
+	b.fetched = fetched
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), b.segments.timeout)
+		defer cancel()
+
+		s, err := b.segments.mysql.FetchSegment(ctx, b.bizTag)
+		if err == nil && s.End <= s.Start {
+			err = fmt.Errorf("the step of biz tag %q is %d, it has to be positive", b.bizTag, s.Step)
+		}
+		if err != nil {
+			segmentFetchCounter.Inc(b.bizTag, "failed")
+			xlog.Error("fetch segment failed", zap.String("bizTag", b.bizTag), zap.Error(err))
+		} else {
+			segmentFetchCounter.Inc(b.bizTag, "ok")
+		}
+
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		b.err = err
+		if err == nil {
+			b.next = &segment{value: s.Start, max: s.End, step: s.Step}
+		}
+		b.fetched = nil
+		close(fetched)
+	}()
+}
+
+// GetSegmentId the next id of req.BizTag from the segments of leaf_alloc, the ids of a biz tag are dense
+// and increasing on one instance, instances hand out interleaving segments
+func (u *Uuid) GetSegmentId(ctx context.Context, req *uuidv1.GetSegmentIdRequest) (*uuidv1.GetSegmentIdResponse, error) {
+	if u.segments == nil {
+		return nil, ErrSegmentDisabled
+	}
+
+	if req.GetBizTag() == "" {
+		return nil, ErrUnknownBizTag.WithMsg("biz_tag is empty")
+	}
+
+	id, err := u.segments.nextId(ctx, req.GetBizTag())
+	if errors.Is(err, mysqlCli.ErrUnknownBizTag) {
+		return nil, ErrUnknownBizTag.WithMsg(fmt.Sprintf("biz tag %q is not in leaf_alloc", req.GetBizTag()))
+	}
+	if err != nil {
+		return nil, ErrSegmentUnavailable.WithMsg(err.Error())
+	}
+
+	return &uuidv1.GetSegmentIdResponse{
+		Error: 0,
+		Msg:   "success",
+		Data: &uuidv1.GetSegmentIdResponse_Data{
+			Uuid: strconv.FormatInt(id, 10),
+			Id:   id,
+		},
+	}, nil
+}
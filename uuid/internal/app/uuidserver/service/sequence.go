@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	uuidv1 "github.com/douyu/jupiter-examples/uuid/gen/api/go/uuid/v1"
+	redisCli "github.com/douyu/jupiter-examples/uuid/internal/pkg/redis"
+	"github.com/douyu/jupiter/pkg/core/metric"
+	"github.com/douyu/jupiter/pkg/xlog"
+	"go.uber.org/zap"
+)
+
+const (
+	// SequenceResetNever sequences that count on forever
+	SequenceResetNever = "never"
+	// SequenceResetDaily sequences that start over every day
+	SequenceResetDaily = "daily"
+	// SequenceResetMonthly sequences that start over every month
+	SequenceResetMonthly = "monthly"
+	// SequenceResetYearly sequences that start over every year
+	SequenceResetYearly = "yearly"
+)
+
+// sequenceReserveCounter counts the ranges reserved in redis by sequence key and result
+var sequenceReserveCounter = metric.NewCounterVec("uuid_sequence_reserve_total", []string{"key", "result"})
+
+// SequenceConfig how the numbers of a sequence are handed out
+type SequenceConfig struct {
+	// Start the first number of the sequence and of each of its periods, 1 if 0.
+	// It only applies to a sequence that is not in redis yet
+	Start int64
+	// CacheSize how many numbers an instance reserves at once, 1 if 0. The numbers an instance reserved but
+	// did not hand out are lost when it stops, and instances hand out their ranges side by side,
+	// so only a CacheSize of 1 keeps the sequence free of gaps and increasing across instances
+	CacheSize int64
+	// Reset never, daily, monthly or yearly, the sequence starts over at Start with every period of the local time
+	Reset string
+}
+
+// sequence the range of a sequence the instance reserved in redis and did not hand out yet
+type sequence struct {
+	key    string
+	config SequenceConfig
+
+	mu sync.Mutex
+	// period the range was reserved for
+	period string
+	// next the next number of the range, left how many numbers of the range are left
+	next, left int64
+}
+
+// newSequences checks the sequences of config
+func newSequences(configs map[string]SequenceConfig) (map[string]*sequence, error) {
+	result := make(map[string]*sequence, len(configs))
+	for key, config := range configs {
+		if key == "" {
+			return nil, fmt.Errorf("snowflake Sequences err,a sequence needs a key")
+		}
+
+		if config.Start < 0 {
+			return nil, fmt.Errorf("snowflake Sequences err,start %d of %q must not be negative", config.Start, key)
+		}
+		if config.Start == 0 {
+			config.Start = 1
+		}
+
+		if config.CacheSize < 0 {
+			return nil, fmt.Errorf("snowflake Sequences err,cacheSize %d of %q must not be negative", config.CacheSize, key)
+		}
+		if config.CacheSize == 0 {
+			config.CacheSize = 1
+		}
+
+		switch config.Reset {
+		case "":
+			config.Reset = SequenceResetNever
+		case SequenceResetNever, SequenceResetDaily, SequenceResetMonthly, SequenceResetYearly:
+		default:
+			return nil, fmt.Errorf("snowflake Sequences err,reset %q of %q is none of never, daily, monthly and yearly", config.Reset, key)
+		}
+
+		result[key] = &sequence{key: key, config: config}
+	}
+	return result, nil
+}
+
+// period the period of now and how long redis keeps the sequence of a period after its last reservation,
+// two periods, so the sequence outlives its period however late in it the last number was reserved
+func (config SequenceConfig) period(now time.Time) (string, time.Duration) {
+	switch config.Reset {
+	case SequenceResetDaily:
+		return now.Format("2006-01-02"), 2 * 24 * time.Hour
+	case SequenceResetMonthly:
+		return now.Format("2006-01"), 2 * 31 * 24 * time.Hour
+	case SequenceResetYearly:
+		return now.Format("2006"), 2 * 366 * 24 * time.Hour
+	}
+	return "", 0
+}
+
+// nextNumber the next number of the sequence and its period, a new range is reserved once the range is used up
+// or the period is over
+func (s *sequence) nextNumber(ctx context.Context, redis redisCli.RedisInterface) (int64, string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	period, ttl := s.config.period(time.Now())
+	if s.left == 0 || period != s.period {
+		key := s.key
+		if period != "" {
+			key += "." + period
+		}
+
+		last, err := redis.ReserveSequence(ctx, key, s.config.CacheSize, s.config.Start, ttl)
+		if err != nil {
+			sequenceReserveCounter.Inc(s.key, "failed")
+			xlog.Error("reserve sequence failed", zap.String("key", key), zap.Error(err))
+			return 0, "", err
+		}
+		sequenceReserveCounter.Inc(s.key, "ok")
+
+		s.period = period
+		s.next, s.left = last-s.config.CacheSize+1, s.config.CacheSize
+	}
+
+	number := s.next
+	s.next++
+	s.left--
+	return number, period, nil
+}
+
+// GetSequence the next number of the sequence req.Key, the numbers of a sequence are strictly increasing
+// and start over with every period of a sequence that resets
+func (u *Uuid) GetSequence(ctx context.Context, req *uuidv1.GetSequenceRequest) (*uuidv1.GetSequenceResponse, error) {
+	sequence, ok := u.sequences[req.GetKey()]
+	if !ok {
+		return nil, ErrUnknownSequence.WithMsg(fmt.Sprintf("sequence %q is not in Sequences", req.GetKey()))
+	}
+
+	number, period, err := sequence.nextNumber(ctx, u.Redis)
+	if err != nil {
+		return nil, ErrSequenceUnavailable.WithMsg(err.Error())
+	}
+
+	return &uuidv1.GetSequenceResponse{
+		Error: 0,
+		Msg:   "success",
+		Data: &uuidv1.GetSequenceResponse_Data{
+			Uuid:   strconv.FormatInt(number, 10),
+			Id:     number,
+			Period: period,
+		},
+	}, nil
+}
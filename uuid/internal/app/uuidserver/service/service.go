@@ -2,20 +2,32 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"strconv"
 	"sync"
+	"time"
 
-	"github.com/bwmarrin/snowflake"
 	uuidv1 "github.com/douyu/jupiter-examples/uuid/gen/api/go/uuid/v1"
+	etcdCli "github.com/douyu/jupiter-examples/uuid/internal/pkg/etcd"
+	mysqlCli "github.com/douyu/jupiter-examples/uuid/internal/pkg/mysql"
 	redisCli "github.com/douyu/jupiter-examples/uuid/internal/pkg/redis"
+	xsnowflake "github.com/douyu/jupiter-examples/uuid/pkg/snowflake"
+	"github.com/douyu/jupiter/pkg/core/hooks"
+	"github.com/douyu/jupiter/pkg/core/metric"
+	"github.com/douyu/jupiter/pkg/xlog"
 	"github.com/google/uuid"
 	"github.com/google/wire"
+	"go.uber.org/zap"
 )
 
 var ProviderSet = wire.NewSet(
 	NewUuidService,
 	wire.Struct(new(Options), "*"),
 	redisCli.ProviderSet,
+	etcdCli.ProviderSet,
+	mysqlCli.ProviderSet,
 )
 
 // Options wireservice
@@ -24,54 +36,613 @@ type Options struct {
 	// UuidGrpc grpc.UuidInterface
 	// ExampleMysql mysql.ExampleInterface
 	Redis redisCli.RedisInterface
+	Etcd  etcdCli.EtcdInterface
+	Mysql mysqlCli.MysqlInterface
 }
 
+// clockRollbackCounter counts the clock rollbacks by policy and whether the id was refused
+var clockRollbackCounter = metric.NewCounterVec("uuid_snowflake_clock_rollback_total", []string{"policy", "result"})
+
+// defaultStreamChunkSize the ids per chunk of StreamUuidBySnowflake if the client leaves it to the server
+const defaultStreamChunkSize = 100
+
 type Uuid struct {
 	// snowflake Generated by default, nodeId cannot exceed 1023, and 0 ID is not used.
-	// The uuid generator corresponding to the global node
+	// The uuid generators of the node by name, "" is the one of [jupiter.server.uuid].
+	// snowflakeRw guards the generators, nodeId and nodeLease, they are swapped once a lost node id is leased again
 	snowflakeRw  *sync.RWMutex
-	snowflakeMap *snowflake.Node
+	snowflakeMap map[string]*xsnowflake.Generator
 	nodeId       int64
-	enableRedis  bool
+	// maxNodeId the largest node id that fits the layouts of all generators
+	maxNodeId int64
+	// datacenterId the datacenter of the region, it is only minted by the layouts with datacenter bits
+	datacenterId int64
+	// layouts the epoch and bits the ids of every generator are minted with
+	layouts map[string]xsnowflake.Layout
+	// ulid and ksuid keep the ids of the node increasing within their time resolution
+	ulid  *ulidGenerator
+	ksuid *ksuidGenerator
+	// namespaces the namespaces of name-based uuids by alias
+	namespaces map[string]uuid.UUID
+	// prefixes the registered prefixes of public ids
+	prefixes map[string]PrefixConfig
+	// obfuscator is set when ObfuscationKeys are configured
+	obfuscator *obfuscator
+	// segments is set when EnableSegment is
+	segments *segments
+	// sequences the redis sequences by key
+	sequences map[string]*sequence
+	// idempotency is set when IdempotencyStore is
+	idempotency IdempotencyStore
+	config      *Config
+	// allocator leases another node id once nodeLease is lost
+	allocator NodeIDAllocator
+	// nodeLease is set when the node id is claimed from a shared backend like redis or etcd
+	nodeLease NodeLease
+	// nodeLeases is set when EnableNodeLeases is
+	nodeLeases NodeLeasePool
+	// highWaterMark is set when the time of the last id is kept across restarts
+	highWaterMark HighWaterMarkStore
+	// savedMark the mark last saved for savedNodeId
+	savedMark   time.Time
+	savedNodeId int64
+
+	stop      chan struct{}
+	wg        sync.WaitGroup
+	closeOnce sync.Once
 	Options
 }
 
 // NewUuidService 创建uuid服务
-func NewUuidService(options Options) *Uuid {
-	uuidServer := StdConfig(ModName).MustBuild()
+func NewUuidService(options Options) (*Uuid, error) {
+	uuidServer, err := StdConfig(ModName).Build()
+	if err != nil {
+		return nil, err
+	}
 	uuidServer.Options = options
 
-	// get node id through redis
-	if uuidServer.enableRedis {
-		nodeId, err := options.Redis.GetNodeId()
+	allocator, err := newNodeIDAllocator(uuidServer.config, options)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeId, err := allocator.Allocate(context.TODO(), uuidServer.maxNodeId)
+	if err != nil {
+		return nil, err
+	}
+	xlog.Info("uuid node id allocated", zap.String("allocator", allocator.Name()), zap.Int64("nodeId", nodeId.ID), zap.String("reason", nodeId.Reason),
+		zap.Int64("datacenterId", uuidServer.datacenterId))
+
+	uuidServer.allocator = allocator
+	uuidServer.nodeId = nodeId.ID
+	uuidServer.nodeLease = nodeId.Lease
+
+	uuidServer.highWaterMark, err = newHighWaterMarkStore(uuidServer.config, options)
+	if err != nil {
+		uuidServer.Close()
+		return nil, err
+	}
+
+	uuidServer.snowflakeMap, err = uuidServer.newGenerators(context.TODO(), uuidServer.nodeId)
+	if err != nil {
+		uuidServer.Close()
+		return nil, err
+	}
+
+	uuidServer.idempotency, err = newIdempotencyStore(context.TODO(), uuidServer.config, options)
+	if err != nil {
+		uuidServer.Close()
+		return nil, err
+	}
+
+	uuidServer.nodeLeases = newNodeLeasePool(uuidServer.config, options)
+
+	if uuidServer.config.EnableSegment {
+		uuidServer.segments = newSegments(options.Mysql, uuidServer.config.SegmentPrefetchThreshold, uuidServer.config.SegmentFetchTimeout)
+	}
+
+	if uuidServer.highWaterMark != nil {
+		uuidServer.wg.Add(1)
+		go uuidServer.keepHighWaterMark()
+	}
+
+	if uuidServer.nodeLease != nil {
+		uuidServer.wg.Add(1)
+		go uuidServer.keepNodeId()
+	}
+
+	if uuidServer.nodeLease != nil || uuidServer.highWaterMark != nil {
+		// save the last id and give the node id back once the servers are stopped
+		hooks.Register(hooks.Stage_AfterStop, uuidServer.Close)
+	}
+
+	return uuidServer, nil
+}
+
+// NodeId the snowflake node id of this instance
+func (u *Uuid) NodeId() int64 {
+	u.snowflakeRw.RLock()
+	defer u.snowflakeRw.RUnlock()
+
+	return u.nodeId
+}
+
+// Close saves the high-water mark and releases the node id lease, the service must not generate snowflake ids afterwards
+func (u *Uuid) Close() {
+	u.closeOnce.Do(func() {
+		close(u.stop)
+		u.wg.Wait()
+
+		if u.highWaterMark != nil {
+			u.saveHighWaterMark()
+		}
+
+		if u.nodeLease != nil {
+			u.nodeLease.Release()
+		}
+	})
+}
+
+// newGenerators a generator of nodeId for every layout, built once the clock is past the high-water mark of nodeId
+func (u *Uuid) newGenerators(ctx context.Context, nodeId int64) (map[string]*xsnowflake.Generator, error) {
+	since, err := u.awaitHighWaterMark(ctx, nodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	generators := make(map[string]*xsnowflake.Generator, len(u.layouts))
+	for name, layout := range u.layouts {
+		var datacenterId int64
+		if layout.DatacenterBits > 0 {
+			datacenterId = u.datacenterId
+		}
+
+		generator, err := xsnowflake.GeneratorConfig{
+			Layout:         layout,
+			DatacenterId:   datacenterId,
+			NodeId:         nodeId,
+			RollbackPolicy: xsnowflake.RollbackPolicy(u.config.ClockRollbackPolicy),
+			BorrowLimit:    u.config.ClockRollbackBorrowLimit,
+			Since:          since,
+			OnRollback:     u.onClockRollback,
+		}.Build()
 		if err != nil {
-			panic(fmt.Errorf("get redis node id is %v", err))
+			return nil, fmt.Errorf("snowflake NewGenerator %q err:%v", name, err)
+		}
+
+		generators[name] = generator
+	}
+	return generators, nil
+}
+
+// keepNodeId leases another node id each time the lease of the node id is lost, until Close. Until then the
+// snowflake ids are refused with ErrNodeLeaseExpired, the allocation is retried every third of NodeLeaseTTL
+func (u *Uuid) keepNodeId() {
+	defer u.wg.Done()
+
+	for {
+		u.snowflakeRw.RLock()
+		lost := u.nodeLease.Lost()
+		u.snowflakeRw.RUnlock()
+
+		select {
+		case <-u.stop:
+			return
+		case <-lost:
+		}
+
+		for !u.reallocateNodeId() {
+			select {
+			case <-u.stop:
+				return
+			case <-time.After(u.config.NodeLeaseTTL / 3):
+			}
 		}
-		uuidServer.nodeId = nodeId
 	}
+}
 
-	// Create a new Node with a Node number of nodeId
-	node, err := snowflake.NewNode(uuidServer.nodeId)
+// reallocateNodeId gives the lost node id up, leases another one and swaps the generators for ones of it
+func (u *Uuid) reallocateNodeId() bool {
+	u.snowflakeRw.RLock()
+	lostNodeId, lostLease := u.nodeId, u.nodeLease
+	u.snowflakeRw.RUnlock()
+	lostLease.Release()
+
+	nodeId, err := u.allocator.Allocate(context.TODO(), u.maxNodeId)
 	if err != nil {
-		panic(fmt.Errorf("snowflake NewNode err:%v", err))
+		xlog.Error("uuid node id lost, allocate another one failed", zap.String("allocator", u.allocator.Name()), zap.Int64("lostNodeId", lostNodeId), zap.Error(err))
+		return false
 	}
 
-	uuidServer.snowflakeMap = node
+	generators, err := u.newGenerators(context.TODO(), nodeId.ID)
+	if err != nil {
+		nodeId.Lease.Release()
+		xlog.Error("uuid node id lost, allocate another one failed", zap.String("allocator", u.allocator.Name()), zap.Int64("lostNodeId", lostNodeId),
+			zap.Int64("nodeId", nodeId.ID), zap.Error(err))
+		return false
+	}
+
+	u.snowflakeRw.Lock()
+	u.nodeId, u.nodeLease, u.snowflakeMap = nodeId.ID, nodeId.Lease, generators
+	u.snowflakeRw.Unlock()
 
-	return uuidServer
+	xlog.Info("uuid node id allocated", zap.String("allocator", u.allocator.Name()), zap.Int64("nodeId", nodeId.ID), zap.String("reason", nodeId.Reason),
+		zap.Int64("lostNodeId", lostNodeId), zap.Int64("datacenterId", u.datacenterId))
+	return true
 }
 
-func (u *Uuid) GetUuidBySnowflake(ctx context.Context, req *uuidv1.GetUuidBySnowflakeRequest) (*uuidv1.GetUuidBySnowflakeResponse, error) {
+// awaitHighWaterMark makes sure the clock is past the last id a previous run of the node id may have issued,
+// it returns the mark the generator has to mint after
+func (u *Uuid) awaitHighWaterMark(ctx context.Context, nodeId int64) (time.Time, error) {
+	if u.highWaterMark == nil {
+		return time.Time{}, nil
+	}
+
+	mark, err := u.highWaterMark.Load(ctx, nodeId)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("load high-water mark of node id %d err:%v", nodeId, err)
+	}
+
+	if mark.IsZero() {
+		return mark, nil
+	}
+
+	// the mark is saved once per interval, ids of up to one interval later may have been issued after it
+	mark = mark.Add(u.config.HighWaterMarkInterval)
+	behind := time.Until(mark)
+	if behind < 0 {
+		return mark, nil
+	}
+
+	xlog.Warn("clock is behind the snowflake high-water mark", zap.Int64("nodeId", nodeId), zap.Time("mark", mark),
+		zap.Duration("behind", behind), zap.String("policy", u.config.HighWaterMarkPolicy))
+
+	if u.config.HighWaterMarkPolicy == HighWaterMarkRefuse || behind > u.config.HighWaterMarkMaxWait {
+		return time.Time{}, ErrClockBehindHighWaterMark.WithMsg(fmt.Sprintf("clock is %s behind the high-water mark %s of node id %d",
+			behind, mark.UTC().Format(time.RFC3339Nano), nodeId))
+	}
+
+	time.Sleep(behind)
+	return mark, nil
+}
+
+// keepHighWaterMark saves the time of the last id every HighWaterMarkInterval until Close
+func (u *Uuid) keepHighWaterMark() {
+	defer u.wg.Done()
+
+	ticker := time.NewTicker(u.config.HighWaterMarkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-u.stop:
+			return
+		case <-ticker.C:
+			u.saveHighWaterMark()
+		}
+	}
+}
+
+func (u *Uuid) saveHighWaterMark() {
+	// the generators share the node id, the mark is the last id of any of them
+	var last time.Time
 	u.snowflakeRw.RLock()
-	// Generate a snowflake ID.
-	id := u.snowflakeMap.Generate()
+	nodeId := u.nodeId
+	for _, generator := range u.snowflakeMap {
+		if l := generator.Last(); l.After(last) {
+			last = l
+		}
+	}
 	u.snowflakeRw.RUnlock()
 
+	if nodeId != u.savedNodeId {
+		u.savedMark = time.Time{}
+	}
+	if last.IsZero() || !last.After(u.savedMark) {
+		return
+	}
+
+	if err := u.highWaterMark.Save(context.TODO(), nodeId, last); err != nil {
+		xlog.Error("save snowflake high-water mark failed", zap.Int64("nodeId", nodeId), zap.Time("mark", last), zap.Error(err))
+		return
+	}
+	u.savedMark, u.savedNodeId = last, nodeId
+}
+
+func (u *Uuid) GetUuidBySnowflake(ctx context.Context, req *uuidv1.GetUuidBySnowflakeRequest) (*uuidv1.GetUuidBySnowflakeResponse, error) {
+	if err := u.checkNodeLease(); err != nil {
+		return nil, err
+	}
+
+	if _, err := u.generator(req.GetGenerator()); err != nil {
+		return nil, err
+	}
+
+	format, err := snowflakeFormat(req.GetFormat())
+	if err != nil {
+		return nil, err
+	}
+
+	request := fmt.Sprintf("GetUuidBySnowflake(generator=%q, format=%q)", req.GetGenerator(), format)
+	record, err := u.idempotent(ctx, req.GetIdempotencyKey(), request, func() (*IdempotentRecord, error) {
+		// Generate a snowflake ID.
+		uuids, ids, err := u.generateSnowflakes(req.GetGenerator(), format, 1)
+		if err != nil {
+			return nil, err
+		}
+		return &IdempotentRecord{Uuids: uuids, Ids: ids}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &uuidv1.GetUuidBySnowflakeResponse{
 		Error: 0,
 		Msg:   "success",
 		Data: &uuidv1.GetUuidBySnowflakeResponse_Data{
-			Uuid: id.String(),
+			Uuid: record.Uuids[0],
+			Id:   record.Ids[0],
+		},
+	}, nil
+}
+
+// GetUuidBySnowflakeBatch generates req.Count ids in one go, once the steps of a millisecond are used up
+// the generator waits for the next one, so a large batch spans several milliseconds
+func (u *Uuid) GetUuidBySnowflakeBatch(ctx context.Context, req *uuidv1.GetUuidBySnowflakeBatchRequest) (*uuidv1.GetUuidBySnowflakeBatchResponse, error) {
+	if req.GetCount() == 0 || req.GetCount() > u.config.MaxBatchSize {
+		return nil, ErrInvalidBatchCount.WithMsg(fmt.Sprintf("count must be between 1 and %d", u.config.MaxBatchSize))
+	}
+
+	if err := u.checkNodeLease(); err != nil {
+		return nil, err
+	}
+
+	if _, err := u.generator(req.GetGenerator()); err != nil {
+		return nil, err
+	}
+
+	format, err := snowflakeFormat(req.GetFormat())
+	if err != nil {
+		return nil, err
+	}
+
+	request := fmt.Sprintf("GetUuidBySnowflakeBatch(count=%d, generator=%q, format=%q)", req.GetCount(), req.GetGenerator(), format)
+	record, err := u.idempotent(ctx, req.GetIdempotencyKey(), request, func() (*IdempotentRecord, error) {
+		uuids, ids, err := u.generateSnowflakes(req.GetGenerator(), format, req.GetCount())
+		if err != nil {
+			return nil, err
+		}
+		return &IdempotentRecord{Uuids: uuids, Ids: ids}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &uuidv1.GetUuidBySnowflakeBatchResponse{
+		Error: 0,
+		Msg:   "success",
+		Data: &uuidv1.GetUuidBySnowflakeBatchResponse_Data{
+			Uuids: record.Uuids,
+			Ids:   record.Ids,
+		},
+	}, nil
+}
+
+// StreamUuidBySnowflake keeps handing chunks of snowflake ids to send until ctx is done, send fails or the client
+// closes its side of the stream. The first request of recv opens the stream, every request grants credit for more chunks.
+// A chunk is only generated while there is credit left, once it is used up recv is waited on for more,
+// so a consumer that stops granting credit holds the feed back and no id is minted that it would not take
+func (u *Uuid) StreamUuidBySnowflake(ctx context.Context, recv func() (*uuidv1.StreamUuidBySnowflakeRequest, error), send func(*uuidv1.StreamUuidBySnowflakeResponse) error) error {
+	req, err := recv()
+	if err != nil {
+		return streamEnd(err)
+	}
+
+	chunkSize := req.GetChunkSize()
+	if chunkSize == 0 {
+		chunkSize = defaultStreamChunkSize
+	}
+
+	if chunkSize > u.config.MaxBatchSize {
+		return ErrInvalidBatchCount.WithMsg(fmt.Sprintf("chunk_size must be between 1 and %d", u.config.MaxBatchSize))
+	}
+
+	if _, err := u.generator(req.GetGenerator()); err != nil {
+		return err
+	}
+
+	format, err := snowflakeFormat(req.GetFormat())
+	if err != nil {
+		return err
+	}
+
+	credit := uint64(req.GetCredit())
+	for {
+		for credit == 0 {
+			req, err = recv()
+			if err != nil {
+				return streamEnd(err)
+			}
+			credit += uint64(req.GetCredit())
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		uuids, ids, err := u.generateSnowflakes(req.GetGenerator(), format, chunkSize)
+		if err != nil {
+			return err
+		}
+
+		err = send(&uuidv1.StreamUuidBySnowflakeResponse{
+			Error: 0,
+			Msg:   "success",
+			Data: &uuidv1.StreamUuidBySnowflakeResponse_Data{
+				Uuids: uuids,
+				Ids:   ids,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		credit--
+	}
+}
+
+// streamEnd the error of a stream whose recv failed, none if the client closed its side
+func streamEnd(err error) error {
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	return err
+}
+
+// generator the generator of name, the one of [jupiter.server.uuid] if name is empty
+func (u *Uuid) generator(name string) (*xsnowflake.Generator, error) {
+	u.snowflakeRw.RLock()
+	defer u.snowflakeRw.RUnlock()
+
+	generator, ok := u.snowflakeMap[name]
+	if !ok {
+		return nil, ErrUnknownGenerator.WithMsg(fmt.Sprintf("unknown generator %q", name))
+	}
+	return generator, nil
+}
+
+// layout the layout of the generator of name
+func (u *Uuid) layout(name string) (xsnowflake.Layout, error) {
+	layout, ok := u.layouts[name]
+	if !ok {
+		return xsnowflake.Layout{}, ErrUnknownGenerator.WithMsg(fmt.Sprintf("unknown generator %q", name))
+	}
+	return layout, nil
+}
+
+// checkNodeLease ErrNodeLeaseExpired while the node id is not known to be held
+func (u *Uuid) checkNodeLease() error {
+	u.snowflakeRw.RLock()
+	defer u.snowflakeRw.RUnlock()
+
+	return u.checkNodeLeaseLocked()
+}
+
+func (u *Uuid) checkNodeLeaseLocked() error {
+	// the node id may have been taken over by another instance once its lease is gone
+	if u.nodeLease != nil && !u.nodeLease.Valid() {
+		return ErrNodeLeaseExpired
+	}
+	return nil
+}
+
+// generateSnowflakes generates count ids of the node in order with the generator of name
+func (u *Uuid) generateSnowflakes(name string, format xsnowflake.Format, count uint32) ([]string, []uint64, error) {
+	u.snowflakeRw.RLock()
+	defer u.snowflakeRw.RUnlock()
+
+	// checked under the lock, so no id is minted with a node id that is given up meanwhile
+	if err := u.checkNodeLeaseLocked(); err != nil {
+		return nil, nil, err
+	}
+	generator, ok := u.snowflakeMap[name]
+	if !ok {
+		return nil, nil, ErrUnknownGenerator.WithMsg(fmt.Sprintf("unknown generator %q", name))
+	}
+
+	uuids := make([]string, count)
+	ids := make([]uint64, count)
+	for i := range uuids {
+		id, err := generator.Generate()
+		if err != nil {
+			return nil, nil, ErrClockRollback.WithMsg(err.Error())
+		}
+		uuids[i] = format.Encode(id)
+		ids[i] = uint64(id)
+	}
+
+	return uuids, ids, nil
+}
+
+// snowflakeFormat the format of name, int64 if name is empty
+func snowflakeFormat(name string) (xsnowflake.Format, error) {
+	format, err := xsnowflake.ParseFormat(name)
+	if err != nil {
+		return "", ErrUnknownFormat.WithMsg(err.Error())
+	}
+	return format, nil
+}
+
+// onClockRollback counts and logs every time the generator finds the clock behind the last id it issued,
+// the generators call it under the read lock of snowflakeRw
+func (u *Uuid) onClockRollback(rollback xsnowflake.ClockRollback) {
+	result := "tolerated"
+	if rollback.Err != nil {
+		result = "refused"
+	}
+	clockRollbackCounter.Inc(string(rollback.Policy), result)
+
+	xlog.Warn("snowflake clock moved backwards", zap.Int64("nodeId", u.nodeId), zap.String("policy", string(rollback.Policy)),
+		zap.Duration("behind", rollback.Behind), zap.String("result", result), zap.Error(rollback.Err))
+}
+
+// ParseSnowflake decodes the id of req.Uuid in req.Format, then the time, datacenter, node id and step of a snowflake id with the layout of the generator
+func (u *Uuid) ParseSnowflake(ctx context.Context, req *uuidv1.ParseSnowflakeRequest) (*uuidv1.ParseSnowflakeResponse, error) {
+	layout, err := u.layout(req.GetGenerator())
+	if err != nil {
+		return nil, err
+	}
+
+	format, err := snowflakeFormat(req.GetFormat())
+	if err != nil {
+		return nil, err
+	}
+
+	i, err := format.Decode(req.GetUuid())
+	if err != nil {
+		return nil, ErrInvalidSnowflake.WithMsg(err.Error())
+	}
+
+	id, err := layout.Parse(i)
+	if err != nil {
+		return nil, ErrInvalidSnowflake.WithMsg(err.Error())
+	}
+
+	return &uuidv1.ParseSnowflakeResponse{
+		Error: 0,
+		Msg:   "success",
+		Data: &uuidv1.ParseSnowflakeResponse_Data{
+			Uuid:         req.GetUuid(),
+			Timestamp:    id.Time.UnixMilli(),
+			Time:         id.Time.UTC().Format(time.RFC3339Nano),
+			NodeId:       id.NodeId,
+			Step:         id.Step,
+			DatacenterId: id.DatacenterId,
+			Datacenter:   u.config.datacenterNames()[id.DatacenterId],
+			Id:           uint64(id.ID),
+		},
+	}, nil
+}
+
+// GetSnowflakeRange the bounds of the snowflake ids that can be generated from req.StartTime to req.EndTime
+func (u *Uuid) GetSnowflakeRange(ctx context.Context, req *uuidv1.GetSnowflakeRangeRequest) (*uuidv1.GetSnowflakeRangeResponse, error) {
+	layout, err := u.layout(req.GetGenerator())
+	if err != nil {
+		return nil, err
+	}
+
+	min, max, err := layout.Range(time.UnixMilli(req.GetStartTime()), time.UnixMilli(req.GetEndTime()))
+	if err != nil {
+		return nil, ErrInvalidTimeWindow.WithMsg(err.Error())
+	}
+
+	return &uuidv1.GetSnowflakeRangeResponse{
+		Error: 0,
+		Msg:   "success",
+		Data: &uuidv1.GetSnowflakeRangeResponse_Data{
+			MinUuid: strconv.FormatInt(min, 10),
+			MaxUuid: strconv.FormatInt(max, 10),
 		},
 	}, nil
 }
@@ -85,3 +656,102 @@ func (u *Uuid) GetUuidByGoogleUUIDV4(ctx context.Context, req *uuidv1.GetUuidByG
 		},
 	}, nil
 }
+
+// GetUuidByName the uuid v3 or v5 of req.Name in req.Namespace, the same name always maps to the same uuid
+func (u *Uuid) GetUuidByName(ctx context.Context, req *uuidv1.GetUuidByNameRequest) (*uuidv1.GetUuidByNameResponse, error) {
+	newUuid, err := nameBased(req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, err := u.namespace(req.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+
+	return &uuidv1.GetUuidByNameResponse{
+		Error: 0,
+		Msg:   "success",
+		Data: &uuidv1.GetUuidByNameResponse_Data{
+			Uuid: newUuid(namespace, []byte(req.GetName())).String(),
+		},
+	}, nil
+}
+
+// GetUuidByNameBatch the uuids v3 or v5 of req.Names in req.Namespace, in the order of the names
+func (u *Uuid) GetUuidByNameBatch(ctx context.Context, req *uuidv1.GetUuidByNameBatchRequest) (*uuidv1.GetUuidByNameBatchResponse, error) {
+	if len(req.GetNames()) == 0 || len(req.GetNames()) > int(u.config.MaxBatchSize) {
+		return nil, ErrInvalidBatchCount.WithMsg(fmt.Sprintf("names must be between 1 and %d", u.config.MaxBatchSize))
+	}
+
+	newUuid, err := nameBased(req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, err := u.namespace(req.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+
+	uuids := make([]string, len(req.GetNames()))
+	for i, name := range req.GetNames() {
+		uuids[i] = newUuid(namespace, []byte(name)).String()
+	}
+
+	return &uuidv1.GetUuidByNameBatchResponse{
+		Error: 0,
+		Msg:   "success",
+		Data: &uuidv1.GetUuidByNameBatchResponse_Data{
+			Uuids: uuids,
+		},
+	}, nil
+}
+
+// GetUuidV7 a uuid v7, v7 uuids of the node increase within a millisecond as well
+func (u *Uuid) GetUuidV7(ctx context.Context, req *uuidv1.GetUuidV7Request) (*uuidv1.GetUuidV7Response, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, ErrGenerateFailed.WithMsg(err.Error())
+	}
+
+	return &uuidv1.GetUuidV7Response{
+		Error: 0,
+		Msg:   "success",
+		Data: &uuidv1.GetUuidV7Response_Data{
+			Uuid: id.String(),
+		},
+	}, nil
+}
+
+// GetUlid a ulid, ulids of the node increase within a millisecond as well
+func (u *Uuid) GetUlid(ctx context.Context, req *uuidv1.GetUlidRequest) (*uuidv1.GetUlidResponse, error) {
+	id, err := u.ulid.Generate()
+	if err != nil {
+		return nil, ErrGenerateFailed.WithMsg(err.Error())
+	}
+
+	return &uuidv1.GetUlidResponse{
+		Error: 0,
+		Msg:   "success",
+		Data: &uuidv1.GetUlidResponse_Data{
+			Uuid: id.String(),
+		},
+	}, nil
+}
+
+// GetKsuid a ksuid, ksuids of the node increase within a second as well
+func (u *Uuid) GetKsuid(ctx context.Context, req *uuidv1.GetKsuidRequest) (*uuidv1.GetKsuidResponse, error) {
+	id, err := u.ksuid.Generate()
+	if err != nil {
+		return nil, ErrGenerateFailed.WithMsg(err.Error())
+	}
+
+	return &uuidv1.GetKsuidResponse{
+		Error: 0,
+		Msg:   "success",
+		Data: &uuidv1.GetKsuidResponse_Data{
+			Uuid: id.String(),
+		},
+	}, nil
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"crypto/rand"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/segmentio/ksuid"
+)
+
+// ulidGenerator mints ulids that increase within a millisecond, the random part of the previous one is
+// incremented instead of drawing a new one. UUIDv7 needs no such generator, google/uuid does the same for v7
+type ulidGenerator struct {
+	mu      sync.Mutex
+	entropy *ulid.MonotonicEntropy
+}
+
+func newUlidGenerator() *ulidGenerator {
+	return &ulidGenerator{
+		entropy: ulid.Monotonic(rand.Reader, 0),
+	}
+}
+
+func (g *ulidGenerator) Generate() (ulid.ULID, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return ulid.New(ulid.Timestamp(time.Now()), g.entropy)
+}
+
+// ksuidGenerator mints ksuids that increase within a second, the resolution of a ksuid,
+// by incrementing the payload of the previous one whenever a new random one would not sort after it
+type ksuidGenerator struct {
+	mu   sync.Mutex
+	last ksuid.KSUID
+}
+
+func newKsuidGenerator() *ksuidGenerator {
+	return &ksuidGenerator{}
+}
+
+func (g *ksuidGenerator) Generate() (ksuid.KSUID, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	id, err := ksuid.NewRandom()
+	if err != nil {
+		return ksuid.Nil, err
+	}
+
+	if ksuid.Compare(id, g.last) <= 0 {
+		id = g.last.Next()
+	}
+	g.last = id
+
+	return id, nil
+}
@@ -4,14 +4,18 @@
 package service
 
 import (
+	"github.com/douyu/jupiter-examples/uuid/internal/pkg/etcd"
+	"github.com/douyu/jupiter-examples/uuid/internal/pkg/mysql"
 	"github.com/douyu/jupiter-examples/uuid/internal/pkg/redis"
 	"github.com/google/wire"
 )
 
-func createMockUuidService() *Uuid {
+func createMockUuidService() (*Uuid, error) {
 	panic(wire.Build(
 		NewUuidService,
 		redis.ProviderSet,
+		etcd.ProviderSet,
+		mysql.ProviderSet,
 		wire.Struct(new(Options), "*"),
 	))
 }
@@ -7,16 +7,25 @@
 package service
 
 import (
+	"github.com/douyu/jupiter-examples/uuid/internal/pkg/etcd"
+	"github.com/douyu/jupiter-examples/uuid/internal/pkg/mysql"
 	"github.com/douyu/jupiter-examples/uuid/internal/pkg/redis"
 )
 
 // Injectors from wire.go:
 
-func createMockUuidService() *Uuid {
+func createMockUuidService() (*Uuid, error) {
 	redisInterface := redis.NewRedis()
+	etcdInterface := etcd.NewEtcd()
+	mysqlInterface := mysql.NewMysql()
 	options := Options{
 		Redis: redisInterface,
+		Etcd:  etcdInterface,
+		Mysql: mysqlInterface,
 	}
-	uuid := NewUuidService(options)
-	return uuid
+	uuid, err := NewUuidService(options)
+	if err != nil {
+		return nil, err
+	}
+	return uuid, nil
 }
@@ -0,0 +1,231 @@
+package etcd
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/douyu/jupiter/pkg/client/etcdv3"
+	"github.com/google/wire"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+var (
+	ProviderSet = wire.NewSet(
+		NewEtcd,
+	)
+
+	// the node ids are claimed in the etcd uuidserver already registers itself to
+	etcdConfigKey = "jupiter.registry.default"
+	// every claimed node id is a key attached to the lease of its owner, so it disappears with the lease
+	etcdNodeKeyPrefix = "/jupiter/uuid/node/"
+	// the high-water mark of a node id is not attached to any lease, it has to outlive the claim
+	etcdHighWaterMarkKeyPrefix = "/jupiter/uuid/hwm/"
+
+	// ErrNodeIdExhausted every node id allowed by the node bits is claimed by a live instance
+	ErrNodeIdExhausted = errors.New("etcd: node id pool exhausted")
+	// ErrNodeClaimLost the claim of the node id is gone with its lease or held by another owner
+	ErrNodeClaimLost = errors.New("etcd: node id claim lost")
+)
+
+// NodeClaim a node id claimed in etcd
+type NodeClaim struct {
+	NodeId int64
+	Owner  string
+	// TTL how long the lease of the claim has left
+	TTL time.Duration
+}
+
+type Etcd struct {
+	*etcdv3.Client
+
+	once sync.Once
+}
+
+func NewEtcd() EtcdInterface {
+	return &Etcd{}
+}
+
+// cli dials etcd on first use, so instances that don't take their node id from etcd never connect to it
+func (e *Etcd) cli() *etcdv3.Client {
+	e.once.Do(func() {
+		if e.Client == nil {
+			e.Client = etcdv3.RawConfig(etcdConfigKey).MustSingleton()
+		}
+	})
+	return e.Client
+}
+
+// AcquireNodeId claims the lowest free node id in [1, maxNodeId] for owner under a new lease of ttl
+func (e *Etcd) AcquireNodeId(ctx context.Context, maxNodeId int64, owner string, ttl time.Duration) (int64, clientv3.LeaseID, error) {
+	lease, err := e.cli().Grant(ctx, int64(math.Ceil(ttl.Seconds())))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	nodeId, err := e.claimNodeId(ctx, maxNodeId, owner, lease.ID)
+	if err != nil {
+		_, _ = e.cli().Revoke(context.TODO(), lease.ID)
+		return 0, 0, err
+	}
+
+	return nodeId, lease.ID, nil
+}
+
+func (e *Etcd) claimNodeId(ctx context.Context, maxNodeId int64, owner string, leaseId clientv3.LeaseID) (int64, error) {
+	res, err := e.cli().Get(ctx, etcdNodeKeyPrefix, clientv3.WithPrefix(), clientv3.WithKeysOnly())
+	if err != nil {
+		return 0, err
+	}
+
+	claimed := make(map[string]bool, len(res.Kvs))
+	for _, kv := range res.Kvs {
+		claimed[string(kv.Key)] = true
+	}
+
+	for nodeId := int64(1); nodeId <= maxNodeId; nodeId++ {
+		key := nodeKey(nodeId)
+		if claimed[key] {
+			continue
+		}
+
+		// another instance may have claimed the id since the listing, only take it if the key is still absent
+		txn, err := e.cli().Txn(ctx).
+			If(clientv3.Compare(clientv3.CreateRevision(key), "=", 0)).
+			Then(clientv3.OpPut(key, owner, clientv3.WithLease(leaseId))).
+			Commit()
+		if err != nil {
+			return 0, err
+		}
+		if txn.Succeeded {
+			return nodeId, nil
+		}
+	}
+
+	return 0, ErrNodeIdExhausted
+}
+
+// KeepAliveNodeId renews the lease of a claimed node id until ctx is done, the channel is closed once the lease is gone
+func (e *Etcd) KeepAliveNodeId(ctx context.Context, leaseId clientv3.LeaseID) (<-chan *clientv3.LeaseKeepAliveResponse, error) {
+	return e.cli().KeepAlive(ctx, leaseId)
+}
+
+// ReleaseNodeId revokes the lease, which deletes the node id key with it
+func (e *Etcd) ReleaseNodeId(ctx context.Context, leaseId clientv3.LeaseID) error {
+	_, err := e.cli().Revoke(ctx, leaseId)
+	return err
+}
+
+// RenewNodeClaim keeps the lease of the claim of nodeId alive once and returns its ttl,
+// it fails with ErrNodeClaimLost if owner no longer holds the node id
+func (e *Etcd) RenewNodeClaim(ctx context.Context, nodeId int64, owner string) (time.Duration, error) {
+	kv, err := e.nodeClaim(ctx, nodeId, owner)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := e.cli().KeepAliveOnce(ctx, clientv3.LeaseID(kv.Lease))
+	if errors.Is(err, rpctypes.ErrLeaseNotFound) {
+		return 0, ErrNodeClaimLost
+	}
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(res.TTL) * time.Second, nil
+}
+
+// ReleaseNodeClaim revokes the lease of the claim of nodeId if it is still held by owner
+func (e *Etcd) ReleaseNodeClaim(ctx context.Context, nodeId int64, owner string) error {
+	kv, err := e.nodeClaim(ctx, nodeId, owner)
+	if errors.Is(err, ErrNodeClaimLost) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = e.cli().Revoke(ctx, clientv3.LeaseID(kv.Lease))
+	if errors.Is(err, rpctypes.ErrLeaseNotFound) {
+		return nil
+	}
+	return err
+}
+
+// ListNodeClaims the live claims of node ids, in the order of the node ids
+func (e *Etcd) ListNodeClaims(ctx context.Context) ([]NodeClaim, error) {
+	res, err := e.cli().Get(ctx, etcdNodeKeyPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+
+	claims := make([]NodeClaim, 0, len(res.Kvs))
+	for _, kv := range res.Kvs {
+		nodeId, err := strconv.ParseInt(strings.TrimPrefix(string(kv.Key), etcdNodeKeyPrefix), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		lease, err := e.cli().TimeToLive(ctx, clientv3.LeaseID(kv.Lease))
+		if err != nil {
+			return nil, err
+		}
+		if lease.TTL < 0 {
+			// the lease expired since the listing
+			continue
+		}
+
+		claims = append(claims, NodeClaim{NodeId: nodeId, Owner: string(kv.Value), TTL: time.Duration(lease.TTL) * time.Second})
+	}
+
+	sort.Slice(claims, func(i, j int) bool {
+		return claims[i].NodeId < claims[j].NodeId
+	})
+	return claims, nil
+}
+
+// nodeClaim the key of the claim of nodeId, ErrNodeClaimLost unless owner holds it
+func (e *Etcd) nodeClaim(ctx context.Context, nodeId int64, owner string) (*mvccpb.KeyValue, error) {
+	res, err := e.cli().Get(ctx, nodeKey(nodeId))
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Kvs) == 0 || string(res.Kvs[0].Value) != owner {
+		return nil, ErrNodeClaimLost
+	}
+	return res.Kvs[0], nil
+}
+
+// GetHighWaterMark the unix milliseconds of the last id nodeId reported, 0 if it never did
+func (e *Etcd) GetHighWaterMark(ctx context.Context, nodeId int64) (int64, error) {
+	res, err := e.cli().Get(ctx, highWaterMarkKey(nodeId))
+	if err != nil {
+		return 0, err
+	}
+
+	if len(res.Kvs) == 0 {
+		return 0, nil
+	}
+
+	return strconv.ParseInt(string(res.Kvs[0].Value), 10, 64)
+}
+
+// SetHighWaterMark records the unix milliseconds of the last id of nodeId, the owner of the node id claim is the only writer
+func (e *Etcd) SetHighWaterMark(ctx context.Context, nodeId int64, mark int64) error {
+	_, err := e.cli().Put(ctx, highWaterMarkKey(nodeId), strconv.FormatInt(mark, 10))
+	return err
+}
+
+func highWaterMarkKey(nodeId int64) string {
+	return etcdHighWaterMarkKeyPrefix + strconv.FormatInt(nodeId, 10)
+}
+
+func nodeKey(nodeId int64) string {
+	return etcdNodeKeyPrefix + strconv.FormatInt(nodeId, 10)
+}
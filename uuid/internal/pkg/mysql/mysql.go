@@ -0,0 +1,90 @@
+package mysql
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	xgorm "github.com/douyu/jupiter/pkg/store/gorm"
+	"github.com/google/wire"
+	"gorm.io/gorm"
+)
+
+var (
+	ProviderSet = wire.NewSet(
+		NewMysql,
+	)
+
+	// ErrUnknownBizTag the biz tag has no row in leaf_alloc
+	ErrUnknownBizTag = errors.New("mysql: unknown biz tag")
+)
+
+// LeafAlloc a row of leaf_alloc, max_id is the end of the last segment handed out for the biz tag,
+// a new biz tag starts with max_id 1 so its first id is 1
+type LeafAlloc struct {
+	BizTag      string    `gorm:"column:biz_tag;primaryKey;size:128"`
+	MaxId       int64     `gorm:"column:max_id;not null;default:1"`
+	Step        int64     `gorm:"column:step;not null"`
+	Description string    `gorm:"column:description;size:256;not null;default:''"`
+	UpdateTime  time.Time `gorm:"column:update_time;autoUpdateTime"`
+}
+
+// TableName ...
+func (LeafAlloc) TableName() string {
+	return "leaf_alloc"
+}
+
+// Segment the ids from Start up to End, End excluded, that were reserved for one instance
+type Segment struct {
+	Start int64
+	End   int64
+	// Step the step of the biz tag at the time the segment was reserved
+	Step int64
+}
+
+type Mysql struct {
+	*gorm.DB
+
+	once sync.Once
+}
+
+func NewMysql() MysqlInterface {
+	return &Mysql{}
+}
+
+// db dials jupiter.mysql.uuid on first use, so instances that don't hand out segment ids never connect to it
+func (m *Mysql) db() *gorm.DB {
+	m.once.Do(func() {
+		if m.DB == nil {
+			m.DB = xgorm.StdConfig("uuid").MustSingleton()
+		}
+	})
+	return m.DB
+}
+
+// FetchSegment moves max_id of bizTag a step ahead and returns the ids it moved over, the update and
+// the read are one transaction so concurrent instances never get overlapping segments
+func (m *Mysql) FetchSegment(ctx context.Context, bizTag string) (Segment, error) {
+	var alloc LeafAlloc
+	err := m.db().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&LeafAlloc{}).Where("biz_tag = ?", bizTag).Update("max_id", gorm.Expr("max_id + step"))
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrUnknownBizTag
+		}
+
+		return tx.Where("biz_tag = ?", bizTag).Take(&alloc).Error
+	})
+	if err != nil {
+		return Segment{}, err
+	}
+
+	return Segment{
+		Start: alloc.MaxId - alloc.Step,
+		End:   alloc.MaxId,
+		Step:  alloc.Step,
+	}, nil
+}
@@ -2,8 +2,33 @@
 
 package redis
 
+import (
+	"context"
+	"time"
+)
+
 // RedisInterface ...
 type RedisInterface interface {
-	// todo 采用 redis 的原子操作，这里目前是为了先实现功能
-	GetNodeId() (int64, error)
+	// AcquireNodeId atomically leases the lowest free node id in [1, maxNodeId] to owner for ttl
+	AcquireNodeId(ctx context.Context, maxNodeId int64, owner string, ttl time.Duration) (int64, error)
+	// RenewNodeId extends the lease of nodeId for another ttl, it fails with ErrNodeLeaseLost if owner no longer holds it
+	RenewNodeId(ctx context.Context, nodeId int64, owner string, ttl time.Duration) error
+	// ReleaseNodeId drops the lease of nodeId if it is still held by owner
+	ReleaseNodeId(ctx context.Context, nodeId int64, owner string) error
+	// ListNodeLeases the live leases of the node ids in [1, maxNodeId], in the order of the node ids
+	ListNodeLeases(ctx context.Context, maxNodeId int64) ([]NodeLease, error)
+	// GetHighWaterMark the unix milliseconds of the last id nodeId reported, 0 if it never did
+	GetHighWaterMark(ctx context.Context, nodeId int64) (int64, error)
+	// SetHighWaterMark records the unix milliseconds of the last id of nodeId, the owner of the node id lease is the only writer
+	SetHighWaterMark(ctx context.Context, nodeId int64, mark int64) error
+	// ReserveSequence reserves the next count numbers of the sequence key and returns the last of them,
+	// a sequence that does not exist yet starts at start. A positive ttl expires the sequence ttl after its last reservation
+	ReserveSequence(ctx context.Context, key string, count int64, start int64, ttl time.Duration) (int64, error)
+	// GetIdempotencyKey the record stored for key, nil if there is none
+	GetIdempotencyKey(ctx context.Context, key string) ([]byte, error)
+	// ClaimIdempotencyKey stores record for key for ttl unless key has a record already,
+	// it returns the record of key either way
+	ClaimIdempotencyKey(ctx context.Context, key string, record []byte, ttl time.Duration) ([]byte, error)
+	// MaxMemoryPolicy the maxmemory-policy of the redis server, it decides which keys redis evicts once it is full
+	MaxMemoryPolicy(ctx context.Context) (string, error)
 }
@@ -2,9 +2,13 @@ package redis
 
 import (
 	"context"
-	"strings"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
 
 	xredis "github.com/douyu/jupiter/pkg/client/redis"
+	"github.com/go-redis/redis/v8"
 	"github.com/google/wire"
 )
 
@@ -13,9 +17,92 @@ var (
 		NewRedis,
 	)
 
-	redisNodeIdKey = "jupiter.uuid.node"
+	// every leased node id is a key of its own, so an id is free again as soon as its lease expires.
+	// the keys share a hash tag and the scripts get every key they touch in KEYS, so they stay in one slot on a cluster
+	redisNodeLeaseKeyPrefix = "{jupiter.uuid.node}.lease."
+	// the high-water mark of a node id has no ttl, it has to outlive the lease
+	redisHighWaterMarkKeyPrefix = "{jupiter.uuid.node}.hwm."
+	// every sequence is a counter of its own, one per period if the sequence resets
+	redisSequenceKeyPrefix = "jupiter.uuid.seq."
+	// the ids handed out for an idempotency key, they expire with the ttl of the key
+	redisIdempotencyKeyPrefix = "jupiter.uuid.idem."
+
+	// ErrNodeIdExhausted every node id allowed by the node bits is leased by a live instance
+	ErrNodeIdExhausted = errors.New("redis: node id pool exhausted")
+	// ErrNodeLeaseLost the lease of the node id has expired or is held by another owner
+	ErrNodeLeaseLost = errors.New("redis: node id lease lost")
+)
+
+var (
+	// KEYS the leases of the node ids 1 to max node id, ARGV[1] owner, ARGV[2] ttl in ms
+	acquireNodeIdScript = redis.NewScript(`
+for nodeId, key in ipairs(KEYS) do
+	if redis.call('SET', key, ARGV[1], 'NX', 'PX', ARGV[2]) then
+		return nodeId
+	end
+end
+return -1
+`)
+
+	// KEYS[1] lease, ARGV[1] owner, ARGV[2] ttl in ms
+	renewNodeIdScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+	// KEYS[1] sequence, ARGV[1] count, ARGV[2] the value before the first number, ARGV[3] ttl in ms, 0 keeps it forever
+	reserveSequenceScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	redis.call('SET', KEYS[1], ARGV[2])
+end
+local last = redis.call('INCRBY', KEYS[1], ARGV[1])
+if tonumber(ARGV[3]) > 0 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[3])
+end
+return last
+`)
+
+	// KEYS[1] idempotency key, ARGV[1] record, ARGV[2] ttl in ms
+	claimIdempotencyKeyScript = redis.NewScript(`
+if redis.call('SET', KEYS[1], ARGV[1], 'NX', 'PX', ARGV[2]) then
+	return ARGV[1]
+end
+return redis.call('GET', KEYS[1])
+`)
+
+	// KEYS the leases of the node ids 1 to max node id, returns node id, owner and ttl in ms of every lease
+	listNodeLeasesScript = redis.NewScript(`
+local leases = {}
+for nodeId, key in ipairs(KEYS) do
+	local owner = redis.call('GET', key)
+	if owner then
+		table.insert(leases, nodeId)
+		table.insert(leases, owner)
+		table.insert(leases, redis.call('PTTL', key))
+	end
+end
+return leases
+`)
+
+	// KEYS[1] lease, ARGV[1] owner
+	releaseNodeIdScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
 )
 
+// NodeLease a node id leased in redis
+type NodeLease struct {
+	NodeId int64
+	Owner  string
+	// TTL how long the lease has left
+	TTL time.Duration
+}
+
 type Redis struct {
 	*xredis.Client
 }
@@ -26,16 +113,124 @@ func NewRedis() RedisInterface {
 	}
 }
 
-// todo 采用 redis 的原子操作，这里目前是为了先实现功能
-func (r *Redis) GetNodeId() (int64, error) {
-	nodeID, err := r.CmdOnMaster().Get(context.TODO(), redisNodeIdKey).Int64()
-	if err != nil && !strings.Contains(err.Error(), "redis: nil") {
+// AcquireNodeId atomically leases the lowest free node id in [1, maxNodeId] to owner for ttl
+func (r *Redis) AcquireNodeId(ctx context.Context, maxNodeId int64, owner string, ttl time.Duration) (int64, error) {
+	nodeId, err := acquireNodeIdScript.Run(ctx, r.CmdOnMaster(), nodeLeaseKeys(maxNodeId),
+		owner, ttl.Milliseconds()).Int64()
+	if err != nil {
 		return 0, err
 	}
 
-	nodeID++
+	if nodeId < 0 {
+		return 0, ErrNodeIdExhausted
+	}
+
+	return nodeId, nil
+}
+
+// RenewNodeId extends the lease of nodeId for another ttl, it fails with ErrNodeLeaseLost if owner no longer holds it
+func (r *Redis) RenewNodeId(ctx context.Context, nodeId int64, owner string, ttl time.Duration) error {
+	renewed, err := renewNodeIdScript.Run(ctx, r.CmdOnMaster(), []string{nodeLeaseKey(nodeId)},
+		owner, ttl.Milliseconds()).Int64()
+	if err != nil {
+		return err
+	}
+
+	if renewed == 0 {
+		return ErrNodeLeaseLost
+	}
+
+	return nil
+}
+
+// ReleaseNodeId drops the lease of nodeId if it is still held by owner
+func (r *Redis) ReleaseNodeId(ctx context.Context, nodeId int64, owner string) error {
+	return releaseNodeIdScript.Run(ctx, r.CmdOnMaster(), []string{nodeLeaseKey(nodeId)}, owner).Err()
+}
+
+// ListNodeLeases the live leases of the node ids in [1, maxNodeId], in the order of the node ids
+func (r *Redis) ListNodeLeases(ctx context.Context, maxNodeId int64) ([]NodeLease, error) {
+	values, err := listNodeLeasesScript.Run(ctx, r.CmdOnMaster(), nodeLeaseKeys(maxNodeId)).Slice()
+	if err != nil {
+		return nil, err
+	}
+
+	leases := make([]NodeLease, 0, len(values)/3)
+	for i := 0; i+2 < len(values); i += 3 {
+		nodeId, _ := values[i].(int64)
+		owner, _ := values[i+1].(string)
+		ttl, _ := values[i+2].(int64)
+		leases = append(leases, NodeLease{NodeId: nodeId, Owner: owner, TTL: time.Duration(ttl) * time.Millisecond})
+	}
+	return leases, nil
+}
+
+// GetHighWaterMark the unix milliseconds of the last id nodeId reported, 0 if it never did
+func (r *Redis) GetHighWaterMark(ctx context.Context, nodeId int64) (int64, error) {
+	mark, err := r.CmdOnMaster().Get(ctx, highWaterMarkKey(nodeId)).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	return mark, err
+}
+
+// SetHighWaterMark records the unix milliseconds of the last id of nodeId, the owner of the node id lease is the only writer
+func (r *Redis) SetHighWaterMark(ctx context.Context, nodeId int64, mark int64) error {
+	return r.CmdOnMaster().Set(ctx, highWaterMarkKey(nodeId), mark, 0).Err()
+}
+
+// ReserveSequence reserves the next count numbers of the sequence key and returns the last of them,
+// a sequence that does not exist yet starts at start. A positive ttl expires the sequence ttl after its last reservation
+func (r *Redis) ReserveSequence(ctx context.Context, key string, count int64, start int64, ttl time.Duration) (int64, error) {
+	return reserveSequenceScript.Run(ctx, r.CmdOnMaster(), []string{redisSequenceKeyPrefix + key},
+		count, start-1, ttl.Milliseconds()).Int64()
+}
+
+// GetIdempotencyKey the record stored for key, nil if there is none
+func (r *Redis) GetIdempotencyKey(ctx context.Context, key string) ([]byte, error) {
+	record, err := r.CmdOnMaster().Get(ctx, redisIdempotencyKeyPrefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	return record, err
+}
+
+// ClaimIdempotencyKey stores record for key for ttl unless key has a record already,
+// it returns the record of key either way
+func (r *Redis) ClaimIdempotencyKey(ctx context.Context, key string, record []byte, ttl time.Duration) ([]byte, error) {
+	stored, err := claimIdempotencyKeyScript.Run(ctx, r.CmdOnMaster(), []string{redisIdempotencyKeyPrefix + key},
+		record, ttl.Milliseconds()).Text()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(stored), nil
+}
+
+// MaxMemoryPolicy the maxmemory-policy of the redis server, it decides which keys redis evicts once it is full
+func (r *Redis) MaxMemoryPolicy(ctx context.Context) (string, error) {
+	values, err := r.CmdOnMaster().ConfigGet(ctx, "maxmemory-policy").Result()
+	if err != nil {
+		return "", err
+	}
+	if len(values) != 2 {
+		return "", fmt.Errorf("redis: unexpected reply %v to config get maxmemory-policy", values)
+	}
+	return fmt.Sprint(values[1]), nil
+}
+
+func highWaterMarkKey(nodeId int64) string {
+	return redisHighWaterMarkKeyPrefix + strconv.FormatInt(nodeId, 10)
+}
 
-	r.CmdOnMaster().Set(context.TODO(), redisNodeIdKey, nodeID, 0)
+func nodeLeaseKey(nodeId int64) string {
+	return redisNodeLeaseKeyPrefix + strconv.FormatInt(nodeId, 10)
+}
 
-	return nodeID, nil
+// nodeLeaseKeys the lease keys of the node ids in [1, maxNodeId], the node id of KEYS[i] is i
+func nodeLeaseKeys(maxNodeId int64) []string {
+	keys := make([]string, 0, maxNodeId)
+	for nodeId := int64(1); nodeId <= maxNodeId; nodeId++ {
+		keys = append(keys, nodeLeaseKey(nodeId))
+	}
+	return keys
 }
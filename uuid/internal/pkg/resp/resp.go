@@ -0,0 +1,159 @@
+// Package resp reads the commands of redis clients and writes the replies, in the RESP2 protocol
+package resp
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	// maxArgs the most arguments of a command
+	maxArgs = 1024
+	// maxBulkSize the longest argument of a command
+	maxBulkSize = 64 * 1024
+)
+
+// ErrProtocol the client sent something that is no command
+var ErrProtocol = errors.New("resp: protocol error")
+
+// Reader reads the commands of a connection
+type Reader struct {
+	r *bufio.Reader
+}
+
+// NewReader ...
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: bufio.NewReader(r)}
+}
+
+// Buffered whether more commands of a pipeline were read already
+func (r *Reader) Buffered() bool {
+	return r.r.Buffered() > 0
+}
+
+// wait blocks until the client sent more or the connection failed, what was sent stays to be read
+func (r *Reader) wait() error {
+	_, err := r.r.Peek(1)
+	return err
+}
+
+// ReadCommand the arguments of the next command, an array of bulk strings or an inline command as sent by telnet
+func (r *Reader) ReadCommand() ([]string, error) {
+	for {
+		line, err := r.readLine()
+		if err != nil {
+			return nil, err
+		}
+		if line == "" {
+			continue
+		}
+
+		if line[0] != '*' {
+			if args := strings.Fields(line); len(args) > 0 {
+				return args, nil
+			}
+			continue
+		}
+
+		n, err := strconv.Atoi(line[1:])
+		if err != nil || n > maxArgs {
+			return nil, fmt.Errorf("%w: invalid multibulk length", ErrProtocol)
+		}
+		if n <= 0 {
+			continue
+		}
+
+		args := make([]string, n)
+		for i := range args {
+			if args[i], err = r.readBulk(); err != nil {
+				return nil, err
+			}
+		}
+		return args, nil
+	}
+}
+
+func (r *Reader) readBulk() (string, error) {
+	line, err := r.readLine()
+	if err != nil {
+		return "", err
+	}
+	if line == "" || line[0] != '$' {
+		return "", fmt.Errorf("%w: expected '$', got %q", ErrProtocol, line)
+	}
+
+	n, err := strconv.Atoi(line[1:])
+	if err != nil || n < 0 || n > maxBulkSize {
+		return "", fmt.Errorf("%w: invalid bulk length", ErrProtocol)
+	}
+
+	buf := make([]byte, n+2)
+	if _, err := io.ReadFull(r.r, buf); err != nil {
+		return "", err
+	}
+	if buf[n] != '\r' || buf[n+1] != '\n' {
+		return "", fmt.Errorf("%w: bulk string not terminated by CRLF", ErrProtocol)
+	}
+	return string(buf[:n]), nil
+}
+
+// readLine a line without its CRLF, redis accepts a bare LF for inline commands as well
+func (r *Reader) readLine() (string, error) {
+	line, err := r.r.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	if len(line) > maxBulkSize {
+		return "", fmt.Errorf("%w: line too long", ErrProtocol)
+	}
+	return strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r"), nil
+}
+
+// Writer writes the replies of a connection, they are buffered until Flush
+type Writer struct {
+	w *bufio.Writer
+}
+
+// NewWriter ...
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: bufio.NewWriter(w)}
+}
+
+// WriteString a simple string reply, s must not contain CR or LF
+func (w *Writer) WriteString(s string) {
+	w.w.WriteString("+" + s + "\r\n")
+}
+
+// WriteError an error reply, msg starts with the error kind, ERR for a generic one
+func (w *Writer) WriteError(msg string) {
+	w.w.WriteString("-" + strings.NewReplacer("\r", " ", "\n", " ").Replace(msg) + "\r\n")
+}
+
+// WriteInt an integer reply
+func (w *Writer) WriteInt(n int64) {
+	w.w.WriteString(":" + strconv.FormatInt(n, 10) + "\r\n")
+}
+
+// WriteBulk a bulk string reply
+func (w *Writer) WriteBulk(s string) {
+	w.w.WriteString("$" + strconv.Itoa(len(s)) + "\r\n" + s + "\r\n")
+}
+
+// WriteNull the null bulk string reply
+func (w *Writer) WriteNull() {
+	w.w.WriteString("$-1\r\n")
+}
+
+// WriteArray the header of an array reply of n elements, the elements follow
+func (w *Writer) WriteArray(n int) {
+	w.w.WriteString("*" + strconv.Itoa(n) + "\r\n")
+}
+
+// Flush sends the buffered replies
+func (w *Writer) Flush() error {
+	return w.w.Flush()
+}
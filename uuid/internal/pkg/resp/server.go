@@ -0,0 +1,267 @@
+package resp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/douyu/jupiter/pkg/conf"
+	"github.com/douyu/jupiter/pkg/core/constant"
+	"github.com/douyu/jupiter/pkg/core/ecode"
+	"github.com/douyu/jupiter/pkg/flag"
+	"github.com/douyu/jupiter/pkg/server"
+	"github.com/douyu/jupiter/pkg/util/xnet"
+	"github.com/douyu/jupiter/pkg/xlog"
+	"go.uber.org/zap"
+)
+
+// Config a listener speaking the redis protocol
+type Config struct {
+	// Enable serves the listener, it is off by default
+	Enable bool
+	Host   string
+	Port   int
+	// Network network type, tcp4 by default
+	Network string
+}
+
+// DefaultConfig ...
+func DefaultConfig() *Config {
+	return &Config{
+		Host:    flag.String("host"),
+		Port:    6380,
+		Network: "tcp4",
+	}
+}
+
+// StdConfig the listener of jupiter.server.<name>
+func StdConfig(name string) *Config {
+	key := constant.ConfigKey("server." + name)
+	config := DefaultConfig()
+	if err := conf.UnmarshalKey(key, &config); err != nil && !errors.Is(err, conf.ErrInvalidKey) {
+		xlog.Panic("resp server parse config panic", xlog.FieldErrKind(ecode.ErrKindUnmarshalConfigErr), xlog.FieldErr(err), xlog.FieldKey(key), xlog.FieldValueAny(config))
+	}
+	return config
+}
+
+// Address ...
+func (config *Config) Address() string {
+	return net.JoinHostPort(config.Host, strconv.Itoa(config.Port))
+}
+
+// Handler answers the commands of args, it returns false once the client asked to close the connection
+type Handler interface {
+	Serve(ctx context.Context, w *Writer, args []string) bool
+}
+
+// Build listens on the address of config, the commands are answered by handler
+func (config *Config) Build(handler Handler) (*Server, error) {
+	listener, err := net.Listen(config.Network, config.Address())
+	if err != nil {
+		return nil, fmt.Errorf("resp server listen failed: %w", err)
+	}
+
+	return &Server{
+		handler:  handler,
+		listener: listener,
+		conns:    map[net.Conn]context.CancelFunc{},
+	}, nil
+}
+
+// Server serves the connections of redis clients, it implements the server of jupiter
+type Server struct {
+	handler  Handler
+	listener net.Listener
+
+	mu sync.Mutex
+	// conns the connections and the cancel of the context of their commands
+	conns    map[net.Conn]context.CancelFunc
+	stopping bool
+	wg       sync.WaitGroup
+}
+
+// Serve implements server.Server interface
+func (s *Server) Serve() error {
+	fmt.Printf("[RESP] \x1b[33m%8s\x1b[0m %s\n", "Listen On", s.listener.Addr().String())
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if s.isStopping() {
+				return nil
+			}
+			return err
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		if !s.track(conn, cancel) {
+			cancel()
+			conn.Close()
+			return nil
+		}
+		go s.serveConn(ctx, conn)
+	}
+}
+
+// Stop implements server.Server interface
+// it closes the listener and every connection right away, the commands being answered are canceled
+func (s *Server) Stop() error {
+	s.mu.Lock()
+	s.stopping = true
+	for conn, cancel := range s.conns {
+		cancel()
+		conn.Close()
+	}
+	s.mu.Unlock()
+
+	return s.listener.Close()
+}
+
+// GracefulStop implements server.Server interface
+// it closes the listener, lets the connections finish the commands they have read and closes them once idle
+func (s *Server) GracefulStop(ctx context.Context) error {
+	s.mu.Lock()
+	s.stopping = true
+	for conn := range s.conns {
+		// wakes up the connections waiting for a command
+		conn.SetReadDeadline(time.Now())
+	}
+	s.mu.Unlock()
+	err := s.listener.Close()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return err
+	case <-ctx.Done():
+		s.Stop()
+		return ctx.Err()
+	}
+}
+
+// Info returns server info, used by governor and consumer balancer
+func (s *Server) Info() *server.ServiceInfo {
+	info := server.ApplyOptions(
+		server.WithScheme("redis"),
+		server.WithAddress(xnet.Address(s.listener)),
+		server.WithKind(constant.ServiceProvider),
+	)
+	return &info
+}
+
+// Healthz ...
+func (s *Server) Healthz() bool {
+	return true
+}
+
+// Addr the address the server listens on
+func (s *Server) Addr() net.Addr {
+	return s.listener.Addr()
+}
+
+// serveConn answers the commands of conn in order, the replies of a pipeline are flushed together,
+// ctx is canceled once the client hangs up
+func (s *Server) serveConn(ctx context.Context, conn net.Conn) {
+	defer s.wg.Done()
+	defer s.untrack(conn)
+
+	r, w := NewReader(conn), NewWriter(conn)
+	for {
+		args, err := r.ReadCommand()
+		if err != nil {
+			if errors.Is(err, ErrProtocol) {
+				w.WriteError("ERR " + err.Error())
+				w.Flush()
+			} else if err != io.EOF && !s.isStopping() {
+				xlog.Warn("resp server read command failed", zap.String("remote", conn.RemoteAddr().String()), zap.Error(err))
+			}
+			return
+		}
+
+		var hangup chan struct{}
+		if !r.Buffered() {
+			hangup = s.watchHangup(conn, r)
+		}
+		keepOpen := s.handler.Serve(ctx, w, args)
+		if hangup != nil {
+			// wakes up the watch, a graceful stop meanwhile is seen by isStopping below
+			conn.SetReadDeadline(time.Now())
+			<-hangup
+			conn.SetReadDeadline(time.Time{})
+		}
+		// a client that hung up may still read the reply, as after a half close
+		closing := !keepOpen || ctx.Err() != nil
+		if closing || !r.Buffered() {
+			if err := w.Flush(); err != nil {
+				return
+			}
+		}
+		if closing || s.isStopping() && !r.Buffered() {
+			return
+		}
+	}
+}
+
+// watchHangup cancels the context of conn once the client hangs up while a command is answered,
+// the returned channel is closed once the read of the watch returned
+func (s *Server) watchHangup(conn net.Conn, r *Reader) chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		var netErr net.Error
+		if err := r.wait(); err != nil && !(errors.As(err, &netErr) && netErr.Timeout()) {
+			s.cancel(conn)
+		}
+	}()
+	return done
+}
+
+// track counts conn in, false if the server is stopping already
+func (s *Server) track(conn net.Conn, cancel context.CancelFunc) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stopping {
+		return false
+	}
+	s.conns[conn] = cancel
+	s.wg.Add(1)
+	return true
+}
+
+func (s *Server) untrack(conn net.Conn) {
+	s.cancel(conn)
+	conn.Close()
+
+	s.mu.Lock()
+	delete(s.conns, conn)
+	s.mu.Unlock()
+}
+
+// cancel cancels the context of the commands of conn
+func (s *Server) cancel(conn net.Conn) {
+	s.mu.Lock()
+	cancel := s.conns[conn]
+	s.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+}
+
+func (s *Server) isStopping() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stopping
+}
@@ -0,0 +1,260 @@
+// Package client is the go client of uuidserver. It hands out snowflake uuids out of a buffer that is refilled
+// in the background with GetUuidBySnowflakeBatch, and asks the server directly while the buffer is dry.
+// A LeasedGenerator generates them in-process with a node id leased from the server instead
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	uuidv1 "github.com/douyu/jupiter-examples/uuid/gen/api/go/uuid/v1"
+	"github.com/douyu/jupiter/pkg/core/metric"
+	"github.com/douyu/jupiter/pkg/xlog"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// bufferCounter counts the ids handed out by client and whether the buffer had them, hit / (hit + miss) is the hit rate
+	bufferCounter = metric.NewCounterVec("uuid_client_buffer_total", []string{"client", "result"})
+	// refillCounter counts the refills of the buffer by client and result
+	refillCounter = metric.NewCounterVec("uuid_client_refill_total", []string{"client", "result"})
+	// bufferGauge the ids in the buffer of a client
+	bufferGauge = metric.NewGaugeVec("uuid_client_buffer_size", []string{"client"})
+)
+
+// Error an error uuidserver answered with
+type Error struct {
+	// Code why the rpc failed
+	Code uuidv1.ErrorCode
+	Msg  string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("uuidserver: %s (ecode %d)", e.Msg, e.Code)
+}
+
+// serverError the *Error of the grpc status err carries, err itself if it has no ErrorDetail
+func serverError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	for _, detail := range st.Details() {
+		if detail, ok := detail.(*uuidv1.ErrorDetail); ok {
+			return &Error{Code: detail.GetCode(), Msg: st.Message()}
+		}
+	}
+	return err
+}
+
+// responseError the error of a response, servers before the typed error codes answered errors in the response
+func responseError(code uint32, msg string) error {
+	if code == 0 {
+		return nil
+	}
+	return &Error{Code: uuidv1.ErrorCode(code), Msg: msg}
+}
+
+// Stats the counters of a client since it was built
+type Stats struct {
+	// Hits the ids handed out of the buffer, Misses the ones asked for directly while it was dry
+	Hits, Misses uint64
+	// Refills the successful refills, RefillFailures the failed ones
+	Refills, RefillFailures uint64
+	// Buffered the ids in the buffer now
+	Buffered int
+}
+
+// Client hands out snowflake uuids of uuidserver. The ids of the buffer were minted up to a refill earlier,
+// so they are unique but not ordered by time against the ids of other clients
+type Client struct {
+	cli       uuidv1.UuidServiceClient
+	config    *Config
+	closeConn func() error
+
+	// ids the buffer, only the refill loop puts ids into it
+	ids chan int64
+	// refill wakes up the refill loop, it holds one signal at most
+	refill chan struct{}
+
+	hits, misses, refills, refillFailures uint64
+
+	stop      chan struct{}
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+}
+
+// New a client of cli, for a connection dialed elsewhere. Closing the client leaves the connection open
+func New(cli uuidv1.UuidServiceClient, config *Config) (*Client, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	return newClient(cli, config, nil), nil
+}
+
+func newClient(cli uuidv1.UuidServiceClient, config *Config, closeConn func() error) *Client {
+	c := &Client{
+		cli:       cli,
+		config:    config,
+		closeConn: closeConn,
+		ids:       make(chan int64, config.BufferSize),
+		refill:    make(chan struct{}, 1),
+		stop:      make(chan struct{}),
+	}
+
+	if config.BufferSize > 0 {
+		c.wg.Add(1)
+		go c.refillLoop()
+		c.signalRefill()
+	}
+	return c
+}
+
+// NextId a snowflake uuid out of the buffer, or of the server if the buffer is dry
+func (c *Client) NextId(ctx context.Context) (int64, error) {
+	select {
+	case id := <-c.ids:
+		atomic.AddUint64(&c.hits, 1)
+		bufferCounter.Inc(c.config.Name, "hit")
+		bufferGauge.Set(float64(len(c.ids)), c.config.Name)
+		if len(c.ids) < c.config.RefillThreshold {
+			c.signalRefill()
+		}
+		return id, nil
+	default:
+	}
+
+	atomic.AddUint64(&c.misses, 1)
+	bufferCounter.Inc(c.config.Name, "miss")
+	c.signalRefill()
+
+	res, err := c.cli.GetUuidBySnowflake(ctx, &uuidv1.GetUuidBySnowflakeRequest{Generator: c.config.Generator})
+	if err != nil {
+		return 0, serverError(err)
+	}
+	if err := responseError(res.GetError(), res.GetMsg()); err != nil {
+		return 0, err
+	}
+	return int64(res.GetData().GetId()), nil
+}
+
+// Stats the counters of the client
+func (c *Client) Stats() Stats {
+	return Stats{
+		Hits:           atomic.LoadUint64(&c.hits),
+		Misses:         atomic.LoadUint64(&c.misses),
+		Refills:        atomic.LoadUint64(&c.refills),
+		RefillFailures: atomic.LoadUint64(&c.refillFailures),
+		Buffered:       len(c.ids),
+	}
+}
+
+// Close stops refilling the buffer and closes the connection the client dialed, the ids left in the buffer are dropped
+func (c *Client) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		close(c.stop)
+		c.wg.Wait()
+
+		if c.closeConn != nil {
+			err = c.closeConn()
+		}
+	})
+	return err
+}
+
+func (c *Client) signalRefill() {
+	if c.config.BufferSize == 0 {
+		return
+	}
+
+	select {
+	case c.refill <- struct{}{}:
+	default:
+	}
+}
+
+// refillLoop tops the buffer up whenever it is signaled, until the buffer is full again or a refill fails
+func (c *Client) refillLoop() {
+	defer c.wg.Done()
+
+	for {
+		select {
+		case <-c.stop:
+			return
+		case <-c.refill:
+		}
+
+		if err := c.fill(); err != nil {
+			atomic.AddUint64(&c.refillFailures, 1)
+			refillCounter.Inc(c.config.Name, "failed")
+			var serverErr *Error
+			if errors.As(err, &serverErr) && serverErr.Code == uuidv1.ErrorCode_ERROR_CODE_INVALID_BATCH_COUNT {
+				// no retry gets through, every id is asked for directly until MaxBatchSize is lowered
+				xlog.Error("uuid client refill refused, MaxBatchSize is above maxBatchSize of the server", zap.String("client", c.config.Name),
+					zap.Int("maxBatchSize", c.config.MaxBatchSize), zap.Error(err))
+			} else {
+				xlog.Warn("uuid client refill failed", zap.String("client", c.config.Name), zap.Error(err))
+			}
+
+			// try again later, calls are served by the server directly meanwhile
+			select {
+			case <-c.stop:
+				return
+			case <-time.After(c.config.RetryInterval):
+				c.signalRefill()
+			}
+			continue
+		}
+
+		atomic.AddUint64(&c.refills, 1)
+		refillCounter.Inc(c.config.Name, "ok")
+	}
+}
+
+// fill asks for the ids missing in the buffer, in batches of at most MaxBatchSize
+func (c *Client) fill() error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.RefillTimeout)
+	defer cancel()
+
+	for {
+		count := cap(c.ids) - len(c.ids)
+		if count == 0 {
+			return nil
+		}
+		if count > c.config.MaxBatchSize {
+			count = c.config.MaxBatchSize
+		}
+
+		res, err := c.cli.GetUuidBySnowflakeBatch(ctx, &uuidv1.GetUuidBySnowflakeBatchRequest{
+			Count:     uint32(count),
+			Generator: c.config.Generator,
+		})
+		if err != nil {
+			return serverError(err)
+		}
+		if err := responseError(res.GetError(), res.GetMsg()); err != nil {
+			return err
+		}
+
+		// the loop is the only writer and there were count free slots, so this never blocks
+		ids := res.GetData().GetIds()
+		if len(ids) > count {
+			ids = ids[:count]
+		}
+		for _, id := range ids {
+			c.ids <- int64(id)
+		}
+		bufferGauge.Set(float64(len(c.ids)), c.config.Name)
+
+		if len(ids) < count {
+			// the server handed out fewer ids than asked for, the next refill asks for the rest
+			return nil
+		}
+	}
+}
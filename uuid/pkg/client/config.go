@@ -0,0 +1,154 @@
+package client
+
+import (
+	"fmt"
+	"time"
+
+	uuidv1 "github.com/douyu/jupiter-examples/uuid/gen/api/go/uuid/v1"
+	xsnowflake "github.com/douyu/jupiter-examples/uuid/pkg/snowflake"
+	cegrpc "github.com/douyu/jupiter/pkg/client/grpc"
+	"github.com/douyu/jupiter/pkg/conf"
+	"github.com/douyu/jupiter/pkg/core/constant"
+	"github.com/douyu/jupiter/pkg/core/ecode"
+	"github.com/douyu/jupiter/pkg/xlog"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+)
+
+// Config the buffer of a client, the connection is configured by the jupiter grpc client config of the same key
+type Config struct {
+	// Name the key of the config, it labels the metrics of the client
+	Name string
+	// Generator the generator of the uuids, the one of [jupiter.server.uuid] if empty
+	Generator string
+	// BufferSize the most ids kept in the buffer. 0 turns the buffer off
+	BufferSize int
+	// MaxBatchSize the most ids asked for in one batch, at most maxBatchSize of the server.
+	// A refill of more ids is split into batches of it
+	MaxBatchSize int
+	// RefillThreshold the buffer is refilled once it holds fewer ids
+	RefillThreshold int
+	// RefillTimeout how long a refill may take
+	RefillTimeout time.Duration
+	// RetryInterval how long the buffer waits after a failed refill before it tries again
+	RetryInterval time.Duration
+
+	// Owner names the client in the node ids it leases for a LeasedGenerator, the app name if empty
+	Owner string
+	// LeaseTimeout how long leasing, renewing or releasing a node id may take
+	LeaseTimeout time.Duration
+	// ClockRollbackPolicy what a LeasedGenerator does while the clock is behind the last id of its node id,
+	// or behind the high-water mark it leased the node id with: block, error or borrow, error by default
+	ClockRollbackPolicy string
+	// ClockRollbackBorrowLimit how far the borrow policy may run ahead of the clock
+	ClockRollbackBorrowLimit time.Duration
+	// Now the wall clock of a LeasedGenerator, time.Now by default
+	Now func() time.Time
+
+	grpc *cegrpc.Config
+}
+
+// DefaultConfig ...
+func DefaultConfig() *Config {
+	return &Config{
+		BufferSize:      1000,
+		MaxBatchSize:    10000,
+		RefillThreshold: 500,
+		RefillTimeout:   time.Second,
+		RetryInterval:   time.Second,
+		LeaseTimeout:    time.Second,
+
+		ClockRollbackPolicy:      string(xsnowflake.RollbackError),
+		ClockRollbackBorrowLimit: time.Second,
+	}
+}
+
+// StdConfig the client of jupiter.grpc.<name>, the buffer is configured under jupiter.grpc.<name>.buffer
+func StdConfig(name string) *Config {
+	return RawConfig(constant.ConfigKey("grpc." + name))
+}
+
+// RawConfig ...
+func RawConfig(key string) *Config {
+	var config = DefaultConfig()
+	config.Name = key
+	if err := conf.UnmarshalKey(key+".buffer", &config); err != nil &&
+		errors.Cause(err) != conf.ErrInvalidKey {
+		xlog.Panic("uuid client parse config panic", xlog.FieldErrKind(ecode.ErrKindUnmarshalConfigErr), xlog.FieldErr(err), xlog.FieldKey(key+".buffer"), xlog.FieldValueAny(config))
+	}
+	config.grpc = cegrpc.RawConfig(key)
+	return config
+}
+
+// Build dials uuidserver and starts filling the buffer
+func (config *Config) Build() (*Client, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	grpcConfig := config.grpc
+	if grpcConfig == nil {
+		grpcConfig = cegrpc.RawConfig(config.Name)
+	}
+	conn, err := grpcConfig.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return newClient(uuidv1.NewUuidServiceClient(conn), config, conn.Close), nil
+}
+
+// BuildLeased dials uuidserver and leases a node id to generate snowflake uuids with in-process
+func (config *Config) BuildLeased() (*LeasedGenerator, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	grpcConfig := config.grpc
+	if grpcConfig == nil {
+		grpcConfig = cegrpc.RawConfig(config.Name)
+	}
+	conn, err := grpcConfig.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	generator, err := newLeased(uuidv1.NewUuidServiceClient(conn), config, conn.Close)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return generator, nil
+}
+
+// MustBuild panics when error found.
+func (config *Config) MustBuild() *Client {
+	return lo.Must(config.Build())
+}
+
+func (config *Config) validate() error {
+	if config.BufferSize < 0 {
+		return fmt.Errorf("uuid client BufferSize:%v err,must not be negative", config.BufferSize)
+	}
+	if config.RefillThreshold < 0 || config.BufferSize > 0 && config.RefillThreshold >= config.BufferSize {
+		return fmt.Errorf("uuid client RefillThreshold:%v err,must be between 0 and BufferSize %v", config.RefillThreshold, config.BufferSize)
+	}
+	if config.MaxBatchSize <= 0 {
+		return fmt.Errorf("uuid client MaxBatchSize:%v err,must be positive", config.MaxBatchSize)
+	}
+	if config.RefillTimeout <= 0 {
+		return fmt.Errorf("uuid client RefillTimeout:%v err,must be positive", config.RefillTimeout)
+	}
+	if config.RetryInterval <= 0 {
+		return fmt.Errorf("uuid client RetryInterval:%v err,must be positive", config.RetryInterval)
+	}
+	if config.LeaseTimeout <= 0 {
+		return fmt.Errorf("uuid client LeaseTimeout:%v err,must be positive", config.LeaseTimeout)
+	}
+	switch xsnowflake.RollbackPolicy(config.ClockRollbackPolicy) {
+	case xsnowflake.RollbackBlock, xsnowflake.RollbackError, xsnowflake.RollbackBorrow:
+	default:
+		return fmt.Errorf("uuid client ClockRollbackPolicy:%v err,expect block, error or borrow", config.ClockRollbackPolicy)
+	}
+	return nil
+}
@@ -0,0 +1,285 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	uuidv1 "github.com/douyu/jupiter-examples/uuid/gen/api/go/uuid/v1"
+	xsnowflake "github.com/douyu/jupiter-examples/uuid/pkg/snowflake"
+	"github.com/douyu/jupiter/pkg"
+	"github.com/douyu/jupiter/pkg/core/metric"
+	"github.com/douyu/jupiter/pkg/xlog"
+	"go.uber.org/zap"
+)
+
+var (
+	// leaseCounter counts the node id leases of a client by operation and result
+	leaseCounter = metric.NewCounterVec("uuid_client_lease_total", []string{"client", "op", "result"})
+	// clockRollbackCounter counts the clock rollbacks of the leased generators of a client by policy and result
+	clockRollbackCounter = metric.NewCounterVec("uuid_client_clock_rollback_total", []string{"client", "policy", "result"})
+
+	// ErrLeaseExpired the node id lease of the generator is over and no new one could be leased yet
+	ErrLeaseExpired = errors.New("uuid client: node id lease expired")
+
+	// errLeaseLost the server no longer knows the lease, the node id may be held by someone else already
+	errLeaseLost = errors.New("uuid client: node id lease lost")
+)
+
+// LeasedGenerator generates snowflake uuids in-process with a node id leased from uuidserver, so no id costs a
+// network hop. The lease is renewed every third of its ttl, the generator stops generating once it is over and
+// leases another node id. If the server keeps high-water marks, the generator only generates after the mark of the
+// node id, while its clock is behind it ClockRollbackPolicy decides, so a node id moving to a host whose clock is
+// behind the one of the previous holder does not repeat its ids. Otherwise the ids are only unique against the ones
+// of the servers and of other clients as long as the clocks of all of them are in sync
+type LeasedGenerator struct {
+	cli       uuidv1.UuidServiceClient
+	config    *Config
+	closeConn func() error
+
+	mu        sync.RWMutex
+	generator *xsnowflake.Generator
+	nodeId    int64
+	lease     string
+	ttl       time.Duration
+	// deadline unix nano until which the node id is known to be ours
+	deadline int64
+
+	stop      chan struct{}
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+}
+
+// NewLeased leases a node id over cli, for a connection dialed elsewhere. Closing the generator leaves the connection open
+func NewLeased(cli uuidv1.UuidServiceClient, config *Config) (*LeasedGenerator, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	return newLeased(cli, config, nil)
+}
+
+func newLeased(cli uuidv1.UuidServiceClient, config *Config, closeConn func() error) (*LeasedGenerator, error) {
+	g := &LeasedGenerator{
+		cli:       cli,
+		config:    config,
+		closeConn: closeConn,
+		stop:      make(chan struct{}),
+	}
+
+	if err := g.leaseNodeId(); err != nil {
+		return nil, err
+	}
+
+	g.wg.Add(1)
+	go g.renewLoop()
+	return g, nil
+}
+
+// NextId a snowflake uuid of the leased node id, ErrLeaseExpired while the generator holds no lease
+func (g *LeasedGenerator) NextId() (int64, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if time.Now().UnixNano() >= atomic.LoadInt64(&g.deadline) {
+		return 0, ErrLeaseExpired
+	}
+	return g.generator.Generate()
+}
+
+// NodeId the leased node id and whether its lease is still known to be held
+func (g *LeasedGenerator) NodeId() (int64, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return g.nodeId, time.Now().UnixNano() < atomic.LoadInt64(&g.deadline)
+}
+
+// Close stops renewing the lease, gives the node id back and closes the connection the generator dialed
+func (g *LeasedGenerator) Close() error {
+	var err error
+	g.closeOnce.Do(func() {
+		close(g.stop)
+		g.wg.Wait()
+
+		g.mu.Lock()
+		atomic.StoreInt64(&g.deadline, 0)
+		nodeId, lease, last := g.nodeId, g.lease, g.generator.Last()
+		g.mu.Unlock()
+
+		if lease != "" {
+			g.releaseNodeId(nodeId, lease, last)
+		}
+
+		if g.closeConn != nil {
+			err = g.closeConn()
+		}
+	})
+	return err
+}
+
+// leaseNodeId leases a node id and swaps the generator for one of it
+func (g *LeasedGenerator) leaseNodeId() error {
+	ctx, cancel := context.WithTimeout(context.Background(), g.config.LeaseTimeout)
+	defer cancel()
+
+	// count the ttl from before the call, the lease may have been taken at any moment of it
+	start := time.Now()
+	res, err := g.cli.LeaseNodeId(ctx, &uuidv1.LeaseNodeIdRequest{Owner: g.config.owner(), Generator: g.config.Generator})
+	if err != nil {
+		err = serverError(err)
+	} else {
+		err = responseError(res.GetError(), res.GetMsg())
+	}
+	if err != nil {
+		leaseCounter.Inc(g.config.Name, "lease", "failed")
+		return err
+	}
+	leaseCounter.Inc(g.config.Name, "lease", "ok")
+
+	data := res.GetData()
+	var since time.Time
+	if data.GetSince() > 0 {
+		since = time.UnixMilli(data.GetSince())
+	}
+	generator, err := xsnowflake.GeneratorConfig{
+		Layout: xsnowflake.Layout{
+			Epoch:          data.GetEpoch(),
+			DatacenterBits: uint8(data.GetDatacenterBits()),
+			NodeBits:       uint8(data.GetNodeBits()),
+			StepBits:       uint8(data.GetStepBits()),
+		},
+		DatacenterId:   data.GetDatacenterId(),
+		NodeId:         data.GetNodeId(),
+		RollbackPolicy: xsnowflake.RollbackPolicy(g.config.ClockRollbackPolicy),
+		BorrowLimit:    g.config.ClockRollbackBorrowLimit,
+		Since:          since,
+		Now:            g.config.Now,
+		OnRollback:     g.onClockRollback,
+	}.Build()
+	if err != nil {
+		g.releaseNodeId(data.GetNodeId(), data.GetLease(), time.Time{})
+		return err
+	}
+
+	ttl := time.Duration(data.GetTtl()) * time.Millisecond
+	g.mu.Lock()
+	g.generator, g.nodeId, g.lease, g.ttl = generator, data.GetNodeId(), data.GetLease(), ttl
+	atomic.StoreInt64(&g.deadline, start.Add(ttl).UnixNano())
+	g.mu.Unlock()
+
+	xlog.Info("uuid client leased node id", zap.String("client", g.config.Name), zap.Int64("nodeId", data.GetNodeId()), zap.Duration("ttl", ttl),
+		zap.Int64("since", data.GetSince()))
+	return nil
+}
+
+func (g *LeasedGenerator) onClockRollback(rollback xsnowflake.ClockRollback) {
+	result := "tolerated"
+	if rollback.Err != nil {
+		result = "refused"
+	}
+	clockRollbackCounter.Inc(g.config.Name, string(rollback.Policy), result)
+
+	xlog.Warn("uuid client clock is behind the last id of its node id", zap.String("client", g.config.Name), zap.String("policy", string(rollback.Policy)),
+		zap.Duration("behind", rollback.Behind), zap.String("result", result), zap.Error(rollback.Err))
+}
+
+// renewLoop renews the lease every third of its ttl, and leases another node id once it is lost
+func (g *LeasedGenerator) renewLoop() {
+	defer g.wg.Done()
+
+	for {
+		g.mu.RLock()
+		wait := g.ttl / 3
+		g.mu.RUnlock()
+
+		if _, valid := g.NodeId(); !valid {
+			wait = g.config.RetryInterval
+		}
+
+		select {
+		case <-g.stop:
+			return
+		case <-time.After(wait):
+		}
+
+		err := g.renew()
+		if errors.Is(err, errLeaseLost) {
+			xlog.Error("uuid client node id lease lost, leasing another one", zap.String("client", g.config.Name))
+			err = g.leaseNodeId()
+		}
+		if err != nil {
+			// keep the old deadline, the next round tries again
+			xlog.Warn("uuid client renew node id lease failed", zap.String("client", g.config.Name), zap.Error(err))
+		}
+	}
+}
+
+func (g *LeasedGenerator) renew() error {
+	g.mu.RLock()
+	nodeId, lease := g.nodeId, g.lease
+	g.mu.RUnlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), g.config.LeaseTimeout)
+	defer cancel()
+
+	start := time.Now()
+	res, err := g.cli.RenewNodeLease(ctx, &uuidv1.RenewNodeLeaseRequest{NodeId: nodeId, Lease: lease})
+	if err != nil {
+		err = serverError(err)
+	} else {
+		err = responseError(res.GetError(), res.GetMsg())
+	}
+	var serverErr *Error
+	if errors.As(err, &serverErr) && serverErr.Code == uuidv1.ErrorCode_ERROR_CODE_NODE_LEASE_LOST {
+		// stop generating right away
+		atomic.StoreInt64(&g.deadline, 0)
+		leaseCounter.Inc(g.config.Name, "renew", "lost")
+		return errLeaseLost
+	}
+	if err != nil {
+		leaseCounter.Inc(g.config.Name, "renew", "failed")
+		return err
+	}
+	leaseCounter.Inc(g.config.Name, "renew", "ok")
+
+	ttl := time.Duration(res.GetData().GetTtl()) * time.Millisecond
+	g.mu.Lock()
+	g.ttl = ttl
+	atomic.StoreInt64(&g.deadline, start.Add(ttl).UnixNano())
+	g.mu.Unlock()
+	return nil
+}
+
+// releaseNodeId gives the node id back, last the time of the last id generated with it
+func (g *LeasedGenerator) releaseNodeId(nodeId int64, lease string, last time.Time) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.config.LeaseTimeout)
+	defer cancel()
+
+	req := &uuidv1.ReleaseNodeLeaseRequest{NodeId: nodeId, Lease: lease}
+	if !last.IsZero() {
+		req.Last = last.UnixMilli()
+	}
+	res, err := g.cli.ReleaseNodeLease(ctx, req)
+	if err != nil {
+		err = serverError(err)
+	} else {
+		err = responseError(res.GetError(), res.GetMsg())
+	}
+	if err != nil {
+		// the lease expires on its own
+		leaseCounter.Inc(g.config.Name, "release", "failed")
+		xlog.Warn("uuid client release node id lease failed", zap.String("client", g.config.Name), zap.Int64("nodeId", nodeId), zap.Error(err))
+		return
+	}
+	leaseCounter.Inc(g.config.Name, "release", "ok")
+}
+
+// owner the name the leases of the client carry
+func (config *Config) owner() string {
+	if config.Owner != "" {
+		return config.Owner
+	}
+	return pkg.Name()
+}
@@ -0,0 +1,97 @@
+// Package obfuscate hides how fast snowflake ids grow, a keyed permutation maps every 63 bit id to another
+// 63 bit id and back, so the public form of an id tells nothing about its time, node or step
+package obfuscate
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+const (
+	// rounds of the feistel network, four already make it a strong pseudorandom permutation
+	rounds = 8
+	// MinKeySize the shortest key a cipher accepts
+	MinKeySize = 16
+)
+
+var (
+	// ErrShortKey the key is shorter than MinKeySize
+	ErrShortKey = fmt.Errorf("obfuscate: keys need at least %d bytes", MinKeySize)
+	// ErrNegativeID ids are 63 bits, like the snowflake ids they stand for
+	ErrNegativeID = errors.New("obfuscate: id is negative")
+)
+
+// Cipher a keyed format-preserving permutation of the non-negative int64s
+type Cipher struct {
+	key []byte
+}
+
+// NewCipher the cipher of key, the same key always maps an id to the same obfuscated id
+func NewCipher(key []byte) (*Cipher, error) {
+	if len(key) < MinKeySize {
+		return nil, ErrShortKey
+	}
+	return &Cipher{key: append([]byte(nil), key...)}, nil
+}
+
+// Encrypt the obfuscated id of id
+func (c *Cipher) Encrypt(id int64) (int64, error) {
+	if id < 0 {
+		return 0, ErrNegativeID
+	}
+
+	// cycle walking: the network permutes 64 bits, apply it again until the result is 63 bits as well
+	x := uint64(id)
+	for {
+		x = c.permute(x, false)
+		if x <= math.MaxInt64 {
+			return int64(x), nil
+		}
+	}
+}
+
+// Decrypt the id of an obfuscated id
+func (c *Cipher) Decrypt(id int64) (int64, error) {
+	if id < 0 {
+		return 0, ErrNegativeID
+	}
+
+	x := uint64(id)
+	for {
+		x = c.permute(x, true)
+		if x <= math.MaxInt64 {
+			return int64(x), nil
+		}
+	}
+}
+
+// permute runs the balanced feistel network over the two 32 bit halves of x, backwards to invert it
+func (c *Cipher) permute(x uint64, inverse bool) uint64 {
+	left, right := uint32(x>>32), uint32(x)
+	mac := hmac.New(sha256.New, c.key)
+
+	for i := 0; i < rounds; i++ {
+		round := i
+		if inverse {
+			round = rounds - 1 - i
+			left, right = right, left
+		}
+
+		var block [5]byte
+		block[0] = byte(round)
+		binary.BigEndian.PutUint32(block[1:], right)
+		mac.Reset()
+		mac.Write(block[:])
+		left ^= binary.BigEndian.Uint32(mac.Sum(nil))
+
+		if !inverse {
+			left, right = right, left
+		}
+	}
+
+	return uint64(left)<<32 | uint64(right)
+}
@@ -0,0 +1,139 @@
+// Package publicid composes the typed public ids of the uuid server, e.g. ord_2ClJYTlb1eaL: a prefix naming the type,
+// an underscore, the id in fixed width base62 and a check character. Ids of a prefix sort like the ids they carry
+package publicid
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+const (
+	// alphabet is in ascii order, so the fixed width bodies sort like the numbers they encode
+	alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	base     = len(alphabet)
+
+	separator = "_"
+	// maxPayload the most bytes an id may carry
+	maxPayload = 32
+)
+
+var (
+	// ErrMalformed the id is not a prefix, an underscore and a base62 body of a payload
+	ErrMalformed = errors.New("publicid: malformed id")
+	// ErrChecksum the check character does not match the body, the id was mistyped
+	ErrChecksum = errors.New("publicid: checksum mismatch")
+
+	prefixPattern = regexp.MustCompile(`^[a-z][a-z0-9]{0,15}$`)
+
+	digits = func() (digits [256]int8) {
+		for i := range digits {
+			digits[i] = -1
+		}
+		for i := 0; i < base; i++ {
+			digits[alphabet[i]] = int8(i)
+		}
+		return digits
+	}()
+)
+
+// ValidPrefix a prefix is 1 to 16 lower case letters and digits, starting with a letter
+func ValidPrefix(prefix string) bool {
+	return prefixPattern.MatchString(prefix)
+}
+
+// Encode the public id of payload under prefix, payloads of the same length encode to bodies of the same width
+func Encode(prefix string, payload []byte) string {
+	width := bodyWidth(len(payload))
+	body := make([]byte, width, width+1)
+
+	value := new(big.Int).SetBytes(payload)
+	digit, radix := new(big.Int), big.NewInt(int64(base))
+	for i := width - 1; i >= 0; i-- {
+		value.DivMod(value, radix, digit)
+		body[i] = alphabet[digit.Int64()]
+	}
+
+	return prefix + separator + string(append(body, checkCharacter(body)))
+}
+
+// Decode splits a public id into its prefix and payload, it fails with ErrMalformed or ErrChecksum
+func Decode(id string) (prefix string, payload []byte, err error) {
+	i := strings.LastIndex(id, separator)
+	if i < 0 {
+		return "", nil, fmt.Errorf("%w, %q has no prefix", ErrMalformed, id)
+	}
+
+	prefix, body := id[:i], id[i+1:]
+	if !ValidPrefix(prefix) {
+		return "", nil, fmt.Errorf("%w, %q is not a prefix", ErrMalformed, prefix)
+	}
+
+	size := payloadSize(len(body) - 1)
+	if size == 0 {
+		return "", nil, fmt.Errorf("%w, the body of %q has %d characters", ErrMalformed, id, len(body))
+	}
+
+	value, radix := new(big.Int), big.NewInt(int64(base))
+	for _, c := range []byte(body) {
+		if digits[c] < 0 {
+			return "", nil, fmt.Errorf("%w, %q is not a base62 character", ErrMalformed, c)
+		}
+	}
+
+	if !validCheckCharacter([]byte(body)) {
+		return "", nil, fmt.Errorf("%w, %q", ErrChecksum, id)
+	}
+
+	for _, c := range []byte(body[:len(body)-1]) {
+		value.Mul(value, radix).Add(value, big.NewInt(int64(digits[c])))
+	}
+	if value.BitLen() > size*8 {
+		return "", nil, fmt.Errorf("%w, the body of %q overflows %d bytes", ErrMalformed, id, size)
+	}
+
+	return prefix, value.FillBytes(make([]byte, size)), nil
+}
+
+// bodyWidth the base62 characters it takes to hold size bytes
+func bodyWidth(size int) int {
+	return int(math.Ceil(float64(size*8) / math.Log2(float64(base))))
+}
+
+// payloadSize the bytes a body of width holds, 0 if no payload encodes to width
+func payloadSize(width int) int {
+	for size := 1; size <= maxPayload; size++ {
+		if bodyWidth(size) == width {
+			return size
+		}
+	}
+	return 0
+}
+
+// checkCharacter the Luhn mod 62 check character of body, it catches every mistyped character
+// and most swaps of two neighbouring ones
+func checkCharacter(body []byte) byte {
+	sum, factor := 0, 2
+	for i := len(body) - 1; i >= 0; i-- {
+		sum += luhn(int(digits[body[i]]) * factor)
+		factor = 3 - factor
+	}
+	return alphabet[(base-sum%base)%base]
+}
+
+// validCheckCharacter whether the last character of body is the check character of the others
+func validCheckCharacter(body []byte) bool {
+	sum, factor := 0, 1
+	for i := len(body) - 1; i >= 0; i-- {
+		sum += luhn(int(digits[body[i]]) * factor)
+		factor = 3 - factor
+	}
+	return sum%base == 0
+}
+
+func luhn(addend int) int {
+	return addend/base + addend%base
+}
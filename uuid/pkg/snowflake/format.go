@@ -0,0 +1,152 @@
+package snowflake
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Format how an id is written out, every format but int64 only uses characters that are safe in urls
+type Format string
+
+const (
+	// FormatInt64 the decimal form, the default
+	FormatInt64 Format = "int64"
+	// FormatHex lower case hexadecimal
+	FormatHex Format = "hex"
+	// FormatBase32 the lower case alphabet of Crockford, decoding ignores case
+	FormatBase32 Format = "base32"
+	// FormatBase36 digits and lower case letters, decoding ignores case
+	FormatBase36 Format = "base36"
+	// FormatBase58 the alphabet of bitcoin, it leaves out 0, O, I and l
+	FormatBase58 Format = "base58"
+	// FormatBase62 digits, upper and lower case letters, the shortest of the formats
+	FormatBase62 Format = "base62"
+)
+
+const (
+	base32Alphabet = "0123456789abcdefghjkmnpqrstvwxyz"
+	base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+// ErrUnknownFormat the format is not one of the formats of the package
+var ErrUnknownFormat = errors.New("snowflake: unknown format")
+
+var (
+	base32Index = alphabetIndex(base32Alphabet, true)
+	base58Index = alphabetIndex(base58Alphabet, false)
+	base62Index = alphabetIndex(base62Alphabet, false)
+)
+
+// ParseFormat the format of name, FormatInt64 if name is empty
+func ParseFormat(name string) (Format, error) {
+	switch format := Format(name); format {
+	case "":
+		return FormatInt64, nil
+	case FormatInt64, FormatHex, FormatBase32, FormatBase36, FormatBase58, FormatBase62:
+		return format, nil
+	}
+	return "", fmt.Errorf("%w %q, expect one of int64, hex, base32, base36, base58, base62", ErrUnknownFormat, name)
+}
+
+// Encode writes id out in the format, ids are never negative so the encodings carry no sign.
+// Formats that are not known are written out in decimal, ParseFormat them first
+func (f Format) Encode(id int64) string {
+	switch f {
+	case FormatHex:
+		return strconv.FormatUint(uint64(id), 16)
+	case FormatBase32:
+		return encode(uint64(id), base32Alphabet)
+	case FormatBase36:
+		return strconv.FormatUint(uint64(id), 36)
+	case FormatBase58:
+		return encode(uint64(id), base58Alphabet)
+	case FormatBase62:
+		return encode(uint64(id), base62Alphabet)
+	}
+	return strconv.FormatInt(id, 10)
+}
+
+// Decode reads back an id written out by Encode, it fails with ErrInvalidID if id is not in the format
+// or does not fit 63 bits
+func (f Format) Decode(id string) (int64, error) {
+	var (
+		i   uint64
+		err error
+	)
+
+	switch f {
+	case FormatInt64, "":
+		i, err = strconv.ParseUint(id, 10, 63)
+	case FormatHex:
+		i, err = strconv.ParseUint(id, 16, 63)
+	case FormatBase32:
+		i, err = decode(id, base32Alphabet, base32Index)
+	case FormatBase36:
+		i, err = strconv.ParseUint(strings.ToLower(id), 36, 63)
+	case FormatBase58:
+		i, err = decode(id, base58Alphabet, base58Index)
+	case FormatBase62:
+		i, err = decode(id, base62Alphabet, base62Index)
+	default:
+		return 0, fmt.Errorf("%w %q", ErrUnknownFormat, f)
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("%w, %q is not a 63 bit %s", ErrInvalidID, id, f)
+	}
+	return int64(i), nil
+}
+
+func encode(id uint64, alphabet string) string {
+	if id == 0 {
+		return alphabet[:1]
+	}
+
+	base := uint64(len(alphabet))
+	var buf [64]byte
+	i := len(buf)
+	for ; id > 0; id /= base {
+		i--
+		buf[i] = alphabet[id%base]
+	}
+	return string(buf[i:])
+}
+
+func decode(id string, alphabet string, index [256]int8) (uint64, error) {
+	if id == "" {
+		return 0, strconv.ErrSyntax
+	}
+
+	base := uint64(len(alphabet))
+	var i uint64
+	for _, c := range []byte(id) {
+		digit := index[c]
+		if digit < 0 {
+			return 0, strconv.ErrSyntax
+		}
+		if i > (math.MaxInt64-uint64(digit))/base {
+			return 0, strconv.ErrRange
+		}
+		i = i*base + uint64(digit)
+	}
+	return i, nil
+}
+
+// alphabetIndex the digit of every byte of the alphabet, -1 for the rest
+func alphabetIndex(alphabet string, ignoreCase bool) [256]int8 {
+	var index [256]int8
+	for i := range index {
+		index[i] = -1
+	}
+	for i := 0; i < len(alphabet); i++ {
+		index[alphabet[i]] = int8(i)
+		if ignoreCase {
+			index[strings.ToUpper(alphabet[i : i+1])[0]] = int8(i)
+		}
+	}
+	return index
+}
@@ -0,0 +1,215 @@
+package snowflake
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// RollbackPolicy what the generator does once the clock is behind the last id it issued
+type RollbackPolicy string
+
+const (
+	// RollbackBlock waits until the clock has caught up
+	RollbackBlock RollbackPolicy = "block"
+	// RollbackError fails with ErrClockRollback
+	RollbackError RollbackPolicy = "error"
+	// RollbackBorrow keeps minting on a logical clock ahead of the wall clock, at most BorrowLimit ahead
+	RollbackBorrow RollbackPolicy = "borrow"
+)
+
+// ErrClockRollback the clock is behind the last id issued, minting now could repeat an id
+var ErrClockRollback = errors.New("snowflake: clock moved backwards")
+
+// ClockRollback is reported to GeneratorConfig.OnRollback once a rollback begins, every time the clock moves
+// further back during it and once the policy turns from tolerating it to refusing ids or back
+type ClockRollback struct {
+	Policy RollbackPolicy
+	// Behind how far the clock is behind the last id issued
+	Behind time.Duration
+	// Err is set if the id was refused
+	Err error
+}
+
+// GeneratorConfig ...
+type GeneratorConfig struct {
+	Layout Layout
+	// DatacenterId has to be 0 if the layout has no DatacenterBits
+	DatacenterId int64
+	NodeId       int64
+
+	// RollbackPolicy block, error or borrow, error by default
+	RollbackPolicy RollbackPolicy
+	// BorrowLimit how far the logical clock of RollbackBorrow may run ahead of the wall clock
+	BorrowLimit time.Duration
+
+	// Since ids are only minted in the milliseconds after it, e.g. the last id of a previous run of the node
+	Since time.Time
+
+	// Now the wall clock, time.Now by default
+	Now func() time.Time
+	// OnRollback is called once the clock is found behind the last id issued, not for every id minted during the rollback
+	OnRollback func(ClockRollback)
+}
+
+// Generator mints snowflake ids of one node, it reads the wall clock and guards against it going backwards
+type Generator struct {
+	config  GeneratorConfig
+	maxStep int64
+
+	mu sync.Mutex
+	// last the millisecond since epoch of the last id issued
+	last int64
+	step int64
+
+	// rollbackWall the wall clock in milliseconds since epoch the ongoing rollback was last seen at, -1 if there is none
+	rollbackWall int64
+	// rollbackRefused the ongoing rollback was last reported with an error
+	rollbackRefused bool
+}
+
+// Build ...
+func (config GeneratorConfig) Build() (*Generator, error) {
+	if err := config.Layout.Validate(); err != nil {
+		return nil, err
+	}
+
+	if config.DatacenterId < 0 || config.DatacenterId > config.Layout.MaxDatacenterId() {
+		return nil, fmt.Errorf("snowflake: datacenter id must be between 0 and %d, got %d", config.Layout.MaxDatacenterId(), config.DatacenterId)
+	}
+
+	if config.NodeId < 0 || config.NodeId > config.Layout.MaxNodeId() {
+		return nil, fmt.Errorf("snowflake: node id must be between 0 and %d, got %d", config.Layout.MaxNodeId(), config.NodeId)
+	}
+
+	switch config.RollbackPolicy {
+	case "":
+		config.RollbackPolicy = RollbackError
+	case RollbackBlock, RollbackError, RollbackBorrow:
+	default:
+		return nil, fmt.Errorf("snowflake: unknown clock rollback policy %q", config.RollbackPolicy)
+	}
+
+	if config.Now == nil {
+		config.Now = time.Now
+	}
+
+	generator := &Generator{
+		config:       config,
+		maxStep:      config.Layout.MaxStep(),
+		last:         -1,
+		rollbackWall: -1,
+	}
+
+	if !config.Since.IsZero() {
+		// the steps of that millisecond count as used up
+		generator.last = config.Since.UnixMilli() - config.Layout.Epoch
+		generator.step = generator.maxStep
+	}
+
+	return generator, nil
+}
+
+// NodeId ...
+func (g *Generator) NodeId() int64 {
+	return g.config.NodeId
+}
+
+// Last the millisecond of the last id issued, or Since if there is none yet
+func (g *Generator) Last() time.Time {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.last < 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(g.last + g.config.Layout.Epoch)
+}
+
+// Generate mints the next id, ids of a generator are strictly increasing
+func (g *Generator) Generate() (int64, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now, err := g.tick(g.last)
+	if err != nil {
+		return 0, err
+	}
+
+	if now == g.last {
+		g.step = (g.step + 1) & g.maxStep
+		if g.step == 0 {
+			// the steps of this millisecond are used up
+			if now, err = g.tick(g.last + 1); err != nil {
+				return 0, err
+			}
+		}
+	} else {
+		g.step = 0
+	}
+
+	g.last = now
+
+	return now<<timestampShift |
+		g.config.DatacenterId<<(g.config.Layout.NodeBits+g.config.Layout.StepBits) |
+		g.config.NodeId<<g.config.Layout.StepBits |
+		g.step, nil
+}
+
+// tick the millisecond since epoch to mint in, not before min
+func (g *Generator) tick(min int64) (int64, error) {
+	for {
+		now := g.config.Now().UnixMilli() - g.config.Layout.Epoch
+		if now >= g.last {
+			// the clock caught up with the last id, a rollback is over
+			g.rollbackWall = -1
+		}
+
+		if now >= min {
+			return now, nil
+		}
+
+		if now >= g.last {
+			// the clock is fine, it just has to move on to the next millisecond
+			continue
+		}
+
+		behind := time.Duration(g.last-now) * time.Millisecond
+		switch g.config.RollbackPolicy {
+		case RollbackBlock:
+			g.rolledBack(now, behind, nil)
+			time.Sleep(time.Duration(min-now) * time.Millisecond)
+		case RollbackBorrow:
+			if ahead := time.Duration(min-now) * time.Millisecond; ahead > g.config.BorrowLimit {
+				err := fmt.Errorf("%w by %s, the logical clock would be %s ahead, beyond the borrow limit of %s", ErrClockRollback, behind, ahead, g.config.BorrowLimit)
+				g.rolledBack(now, behind, err)
+				return 0, err
+			}
+			g.rolledBack(now, behind, nil)
+			return min, nil
+		default:
+			err := fmt.Errorf("%w by %s", ErrClockRollback, behind)
+			g.rolledBack(now, behind, err)
+			return 0, err
+		}
+	}
+}
+
+// rolledBack reports the rollback the wall clock now is in, unless it was reported already. A rollback is reported
+// again if the clock moved back further or the policy turned between tolerating it and refusing ids
+func (g *Generator) rolledBack(now int64, behind time.Duration, err error) {
+	ongoing := g.rollbackWall >= 0 && now >= g.rollbackWall && (err != nil) == g.rollbackRefused
+	g.rollbackWall, g.rollbackRefused = now, err != nil
+	if ongoing {
+		return
+	}
+
+	if g.config.OnRollback != nil {
+		g.config.OnRollback(ClockRollback{
+			Policy: g.config.RollbackPolicy,
+			Behind: behind,
+			Err:    err,
+		})
+	}
+}
@@ -0,0 +1,135 @@
+// Package snowflake decodes the snowflake ids of the uuid server, the layout has to match
+// the epoch, datacenterBits, nodeBits and stepBits of the [jupiter.server.uuid] that minted them
+package snowflake
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultEpoch the twitter snowflake epoch of Nov 04 2010 01:42:54 UTC in milliseconds
+	DefaultEpoch int64 = 1288834974657
+	// DefaultNodeBits the bits of the node id by default
+	DefaultNodeBits uint8 = 10
+	// DefaultStepBits the bits of the step by default
+	DefaultStepBits uint8 = 12
+
+	// timestampBits the bits of milliseconds since epoch
+	timestampBits = 41
+	// timestampShift the bits shared by the datacenter id, the node id and the step below the timestamp
+	timestampShift = 22
+
+	// maxClockSkew how far ahead of the local clock an id may be, the clocks of the nodes are never exactly in sync
+	maxClockSkew = time.Second
+)
+
+var (
+	// ErrInvalidLayout datacenterBits, nodeBits and stepBits don't share the 22 bits
+	ErrInvalidLayout = errors.New("snowflake: datacenterBits, nodeBits and stepBits must sum to 22")
+	// ErrInvalidID the id could not have been minted under the layout
+	ErrInvalidID = errors.New("snowflake: invalid id")
+	// ErrInvalidWindow the time window is reversed or outside of the 41 bit timestamp of the layout
+	ErrInvalidWindow = errors.New("snowflake: invalid time window")
+)
+
+// Layout how a snowflake id is composed, 41 bits of milliseconds since Epoch, DatacenterBits of datacenter id,
+// NodeBits of node id and StepBits of step. DatacenterBits is 0 in the classic layout
+type Layout struct {
+	Epoch          int64
+	DatacenterBits uint8
+	NodeBits       uint8
+	StepBits       uint8
+}
+
+// ID the parts of a snowflake id
+type ID struct {
+	ID int64
+	// Time when the id was minted, in milliseconds
+	Time         time.Time
+	DatacenterId int64
+	NodeId       int64
+	Step         int64
+}
+
+// DefaultLayout the layout of the uuid server without any configuration
+func DefaultLayout() Layout {
+	return Layout{
+		Epoch:    DefaultEpoch,
+		NodeBits: DefaultNodeBits,
+		StepBits: DefaultStepBits,
+	}
+}
+
+// Validate checks that DatacenterBits, NodeBits and StepBits share the 22 bits next to the timestamp
+func (l Layout) Validate() error {
+	if int(l.DatacenterBits)+int(l.NodeBits)+int(l.StepBits) != timestampShift {
+		return fmt.Errorf("%w, got datacenterBits:%d nodeBits:%d stepBits:%d", ErrInvalidLayout, l.DatacenterBits, l.NodeBits, l.StepBits)
+	}
+	return nil
+}
+
+// MaxDatacenterId the largest datacenter id of the layout
+func (l Layout) MaxDatacenterId() int64 {
+	return -1 ^ (-1 << l.DatacenterBits)
+}
+
+// MaxNodeId the largest node id of the layout
+func (l Layout) MaxNodeId() int64 {
+	return -1 ^ (-1 << l.NodeBits)
+}
+
+// MaxStep the largest step of the layout
+func (l Layout) MaxStep() int64 {
+	return -1 ^ (-1 << l.StepBits)
+}
+
+// Parse splits id into its parts, it fails with ErrInvalidID for negative ids and ids minted in the future
+func (l Layout) Parse(id int64) (ID, error) {
+	if id < 0 {
+		return ID{}, fmt.Errorf("%w, %d is negative", ErrInvalidID, id)
+	}
+
+	ms := id>>timestampShift + l.Epoch
+	minted := time.UnixMilli(ms)
+	if minted.After(time.Now().Add(maxClockSkew)) {
+		return ID{}, fmt.Errorf("%w, %d was minted at %s which is in the future, the layout may not match", ErrInvalidID, id, minted.UTC().Format(time.RFC3339Nano))
+	}
+
+	return ID{
+		ID:           id,
+		Time:         minted,
+		DatacenterId: id >> (l.NodeBits + l.StepBits) & l.MaxDatacenterId(),
+		NodeId:       id >> l.StepBits & l.MaxNodeId(),
+		Step:         id & l.MaxStep(),
+	}, nil
+}
+
+// Range the smallest and the largest id that can be minted from start to end, both inclusive in milliseconds.
+// Every id of the window lies in [min, max], so it can be used to range-scan tables keyed by snowflake ids
+func (l Layout) Range(start, end time.Time) (min int64, max int64, err error) {
+	if end.Before(start) {
+		return 0, 0, fmt.Errorf("%w, end %s is before start %s", ErrInvalidWindow, end.Format(time.RFC3339Nano), start.Format(time.RFC3339Nano))
+	}
+
+	from, to := start.UnixMilli()-l.Epoch, end.UnixMilli()-l.Epoch
+	if from < 0 {
+		return 0, 0, fmt.Errorf("%w, start %s is before the epoch", ErrInvalidWindow, start.Format(time.RFC3339Nano))
+	}
+	if to >= 1<<timestampBits {
+		return 0, 0, fmt.Errorf("%w, end %s is beyond the %d bit timestamp", ErrInvalidWindow, end.Format(time.RFC3339Nano), timestampBits)
+	}
+
+	return from << timestampShift, to<<timestampShift | (-1 ^ (-1 << timestampShift)), nil
+}
+
+// ParseString parses the decimal form of an id
+func (l Layout) ParseString(id string) (ID, error) {
+	i, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return ID{}, fmt.Errorf("%w, %q is not a 64 bit integer", ErrInvalidID, id)
+	}
+	return l.Parse(i)
+}
@@ -0,0 +1,19 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/douyu/jupiter/pkg/conf"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// TestClientSuites runs without uuidserver-local-live.toml: the jupiter grpc client links the etcd registry,
+// which dials the etcd of jupiter.registry.default as soon as a config with it is loaded
+func TestClientSuites(t *testing.T) {
+	RegisterFailHandler(Fail)
+	// conf remembers a key it did not find, so [jupiter.server.uuid] has to exist before the first service reads it,
+	// or the fields the specs set later are never seen
+	conf.Set("jupiter.server.uuid.nodeLeaseTTL", "30s")
+	RunSpecs(t, "client test cases")
+}
@@ -0,0 +1,234 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	uuidv1 "github.com/douyu/jupiter-examples/uuid/gen/api/go/uuid/v1"
+	etcdMocks "github.com/douyu/jupiter-examples/uuid/gen/mocks/etcd"
+	redisMocks "github.com/douyu/jupiter-examples/uuid/gen/mocks/redis"
+	"github.com/douyu/jupiter-examples/uuid/internal/app/uuidserver/controller"
+	"github.com/douyu/jupiter-examples/uuid/internal/app/uuidserver/service"
+	uuidClient "github.com/douyu/jupiter-examples/uuid/pkg/client"
+	"github.com/douyu/jupiter/pkg/conf"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// failingBatchClient a uuidserver whose batches fail, single ids still work
+type failingBatchClient struct {
+	uuidv1.UuidServiceClient
+}
+
+func (c failingBatchClient) GetUuidBySnowflakeBatch(ctx context.Context, in *uuidv1.GetUuidBySnowflakeBatchRequest, opts ...grpc.CallOption) (*uuidv1.GetUuidBySnowflakeBatchResponse, error) {
+	return nil, status.Error(codes.Unavailable, "batches are down")
+}
+
+// countingBatchClient a uuidserver that records the largest batch it was asked for
+type countingBatchClient struct {
+	uuidv1.UuidServiceClient
+	largest *atomic.Uint32
+}
+
+func (c countingBatchClient) GetUuidBySnowflakeBatch(ctx context.Context, in *uuidv1.GetUuidBySnowflakeBatchRequest, opts ...grpc.CallOption) (*uuidv1.GetUuidBySnowflakeBatchResponse, error) {
+	for {
+		largest := c.largest.Load()
+		if in.GetCount() <= largest || c.largest.CompareAndSwap(largest, in.GetCount()) {
+			break
+		}
+	}
+	return c.UuidServiceClient.GetUuidBySnowflakeBatch(ctx, in, opts...)
+}
+
+var _ = Describe("uuid client", func() {
+	var (
+		server *grpc.Server
+		conn   *grpc.ClientConn
+		ctx    = context.Background()
+	)
+
+	BeforeEach(func() {
+		uuidService, err := service.NewUuidService(service.Options{Redis: &redisMocks.RedisInterface{}, Etcd: &etcdMocks.EtcdInterface{}})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		lis := bufconn.Listen(1 << 20)
+		server = grpc.NewServer()
+		uuidv1.RegisterUuidServiceServer(server, controller.NewUUuidGrpcController(uuidService))
+		go server.Serve(lis)
+
+		conn, err = grpc.Dial("bufnet", grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		conn.Close()
+		server.Stop()
+	})
+
+	newConfig := func() *uuidClient.Config {
+		config := uuidClient.DefaultConfig()
+		config.Name = "test"
+		config.BufferSize = 100
+		config.RefillThreshold = 50
+		config.RetryInterval = 50 * time.Millisecond
+		return config
+	}
+
+	It("fills the buffer in the background and hands out its ids", func() {
+		cli, err := uuidClient.New(uuidv1.NewUuidServiceClient(conn), newConfig())
+		Expect(err).ShouldNot(HaveOccurred())
+		defer cli.Close()
+
+		Eventually(func() int { return cli.Stats().Buffered }).Should(Equal(100))
+
+		ids := map[int64]bool{}
+		for i := 0; i < 1000; i++ {
+			id, err := cli.NextId(ctx)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ids).ShouldNot(HaveKey(id))
+			ids[id] = true
+		}
+
+		stats := cli.Stats()
+		Expect(stats.Hits + stats.Misses).Should(Equal(uint64(1000)))
+		Expect(stats.Hits).Should(BeNumerically(">=", 100))
+
+		// topped up again once it fell below the refill threshold
+		Eventually(func() int { return cli.Stats().Buffered }).Should(BeNumerically(">=", 50))
+		Expect(cli.Stats().Refills).Should(BeNumerically(">=", 2))
+		Expect(cli.Stats().RefillFailures).Should(BeZero())
+	})
+
+	It("never hands the same id to concurrent callers", func() {
+		cli, err := uuidClient.New(uuidv1.NewUuidServiceClient(conn), newConfig())
+		Expect(err).ShouldNot(HaveOccurred())
+		defer cli.Close()
+
+		var (
+			wg  sync.WaitGroup
+			mu  sync.Mutex
+			ids = map[int64]bool{}
+		)
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+
+				for j := 0; j < 200; j++ {
+					id, err := cli.NextId(ctx)
+					Expect(err).ShouldNot(HaveOccurred())
+
+					mu.Lock()
+					Expect(ids).ShouldNot(HaveKey(id))
+					ids[id] = true
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+
+		Expect(ids).Should(HaveLen(4000))
+	})
+
+	It("splits a refill into batches of at most MaxBatchSize", func() {
+		largest := &atomic.Uint32{}
+		config := newConfig()
+		config.BufferSize = 1000
+		config.RefillThreshold = 500
+		config.MaxBatchSize = 300
+		cli, err := uuidClient.New(countingBatchClient{uuidv1.NewUuidServiceClient(conn), largest}, config)
+		Expect(err).ShouldNot(HaveOccurred())
+		defer cli.Close()
+
+		Eventually(func() int { return cli.Stats().Buffered }).Should(Equal(1000))
+		Expect(largest.Load()).Should(BeEquivalentTo(300))
+		Expect(cli.Stats().RefillFailures).Should(BeZero())
+
+		ids := map[int64]bool{}
+		for i := 0; i < 1000; i++ {
+			id, err := cli.NextId(ctx)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ids).ShouldNot(HaveKey(id))
+			ids[id] = true
+		}
+		Expect(cli.Stats().Hits).Should(Equal(uint64(1000)))
+	})
+
+	It("asks the server directly while refills fail", func() {
+		cli, err := uuidClient.New(failingBatchClient{uuidv1.NewUuidServiceClient(conn)}, newConfig())
+		Expect(err).ShouldNot(HaveOccurred())
+		defer cli.Close()
+
+		for i := 0; i < 10; i++ {
+			_, err := cli.NextId(ctx)
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+
+		Expect(cli.Stats().Misses).Should(Equal(uint64(10)))
+		Expect(cli.Stats().Hits).Should(BeZero())
+		Eventually(func() uint64 { return cli.Stats().RefillFailures }).Should(BeNumerically(">=", 2))
+	})
+
+	It("reports the errors the server answered with", func() {
+		config := newConfig()
+		config.BufferSize = 0
+		config.RefillThreshold = 0
+		config.Generator = "missing"
+		cli, err := uuidClient.New(uuidv1.NewUuidServiceClient(conn), config)
+		Expect(err).ShouldNot(HaveOccurred())
+		defer cli.Close()
+
+		_, err = cli.NextId(ctx)
+		var serverErr *uuidClient.Error
+		Expect(errors.As(err, &serverErr)).Should(BeTrue())
+		Expect(serverErr.Code).Should(Equal(uuidv1.ErrorCode_ERROR_CODE_UNKNOWN_GENERATOR))
+		Expect(cli.Stats().Refills).Should(BeZero())
+	})
+
+	It("refuses a refill threshold past the buffer size", func() {
+		config := newConfig()
+		config.RefillThreshold = 100
+		_, err := uuidClient.New(uuidv1.NewUuidServiceClient(conn), config)
+		Expect(err).Should(MatchError(ContainSubstring("RefillThreshold")))
+
+		config = newConfig()
+		config.MaxBatchSize = 0
+		_, err = uuidClient.New(uuidv1.NewUuidServiceClient(conn), config)
+		Expect(err).Should(MatchError(ContainSubstring("MaxBatchSize")))
+	})
+
+	It("dials the server of jupiter.grpc.<name>", func() {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ShouldNot(HaveOccurred())
+		uuidService, err := service.NewUuidService(service.Options{Redis: &redisMocks.RedisInterface{}, Etcd: &etcdMocks.EtcdInterface{}})
+		Expect(err).ShouldNot(HaveOccurred())
+		tcpServer := grpc.NewServer()
+		uuidv1.RegisterUuidServiceServer(tcpServer, controller.NewUUuidGrpcController(uuidService))
+		go tcpServer.Serve(lis)
+		defer tcpServer.Stop()
+
+		conf.Set("jupiter.grpc.uuidclient.addr", lis.Addr().String())
+		conf.Set("jupiter.grpc.uuidclient.buffer.bufferSize", 10)
+		conf.Set("jupiter.grpc.uuidclient.buffer.refillThreshold", 5)
+		cli, err := uuidClient.StdConfig("uuidclient").Build()
+		Expect(err).ShouldNot(HaveOccurred())
+		defer cli.Close()
+
+		Eventually(func() int { return cli.Stats().Buffered }).Should(Equal(10))
+		_, err = cli.NextId(ctx)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(cli.Stats().Hits).Should(Equal(uint64(1)))
+	})
+})
@@ -0,0 +1,325 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	uuidv1 "github.com/douyu/jupiter-examples/uuid/gen/api/go/uuid/v1"
+	etcdMocks "github.com/douyu/jupiter-examples/uuid/gen/mocks/etcd"
+	"github.com/douyu/jupiter-examples/uuid/internal/app/uuidserver/controller"
+	"github.com/douyu/jupiter-examples/uuid/internal/app/uuidserver/service"
+	"github.com/douyu/jupiter-examples/uuid/internal/pkg/redis"
+	uuidClient "github.com/douyu/jupiter-examples/uuid/pkg/client"
+	xsnowflake "github.com/douyu/jupiter-examples/uuid/pkg/snowflake"
+	xredis "github.com/douyu/jupiter/pkg/client/redis"
+	"github.com/douyu/jupiter/pkg/conf"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+var _ = Describe("leased generator", func() {
+	var (
+		redisServer *miniredis.Miniredis
+		redisCli    *redis.Redis
+		uuidService *service.Uuid
+		server      *grpc.Server
+		conn        *grpc.ClientConn
+		ctx         = context.Background()
+
+		// highWaterMarkStore the high-water mark store of the server, none unless a context sets it
+		highWaterMarkStore string
+	)
+
+	BeforeEach(func() {
+		highWaterMarkStore = ""
+	})
+
+	JustBeforeEach(func() {
+		redisServer = miniredis.NewMiniRedis()
+		Expect(redisServer.Start()).Should(Succeed())
+		config := xredis.DefaultConfig()
+		config.Master.Addr = redisServer.Addr()
+		redisClient, err := config.Build()
+		Expect(err).ShouldNot(HaveOccurred())
+		redisCli = &redis.Redis{Client: redisClient}
+
+		// a short ttl, so the generators renew several times per spec
+		conf.Set("jupiter.server.uuid.enableRedis", true)
+		conf.Set("jupiter.server.uuid.enableNodeLeases", true)
+		conf.Set("jupiter.server.uuid.nodeLeaseTTL", "600ms")
+		conf.Set("jupiter.server.uuid.highWaterMarkStore", highWaterMarkStore)
+		conf.Set("jupiter.server.uuid.highWaterMarkInterval", "100ms")
+		uuidService, err = service.NewUuidService(service.Options{Redis: redisCli, Etcd: &etcdMocks.EtcdInterface{}})
+		conf.Set("jupiter.server.uuid.enableRedis", false)
+		conf.Set("jupiter.server.uuid.enableNodeLeases", false)
+		conf.Set("jupiter.server.uuid.nodeLeaseTTL", "30s")
+		conf.Set("jupiter.server.uuid.highWaterMarkStore", "")
+		conf.Set("jupiter.server.uuid.highWaterMarkInterval", "1s")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		lis := bufconn.Listen(1 << 20)
+		server = grpc.NewServer()
+		uuidv1.RegisterUuidServiceServer(server, controller.NewUUuidGrpcController(uuidService))
+		go server.Serve(lis)
+
+		conn, err = grpc.Dial("bufnet", grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		conn.Close()
+		server.Stop()
+		uuidService.Close()
+		redisServer.Close()
+	})
+
+	newConfig := func() *uuidClient.Config {
+		config := uuidClient.DefaultConfig()
+		config.Name = "test"
+		config.Owner = "orders"
+		config.RetryInterval = 50 * time.Millisecond
+		return config
+	}
+
+	clientLeases := func() []*uuidv1.ListNodeLeasesResponse_Lease {
+		res, err := uuidService.ListNodeLeases(ctx, &uuidv1.ListNodeLeasesRequest{})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		var leases []*uuidv1.ListNodeLeasesResponse_Lease
+		for _, lease := range res.GetData().GetLeases() {
+			if lease.GetClient() {
+				leases = append(leases, lease)
+			}
+		}
+		return leases
+	}
+
+	It("generates the ids of a node id no server holds, across renewals", func() {
+		generator, err := uuidClient.NewLeased(uuidv1.NewUuidServiceClient(conn), newConfig())
+		Expect(err).ShouldNot(HaveOccurred())
+		defer generator.Close()
+
+		nodeId, valid := generator.NodeId()
+		Expect(valid).Should(BeTrue())
+		Expect(nodeId).ShouldNot(Equal(uuidService.NodeId()))
+
+		// outlive the ttl a few times over
+		ids := map[int64]bool{}
+		for deadline := time.Now().Add(2 * time.Second); time.Now().Before(deadline); {
+			id, err := generator.NextId()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ids[id]).Should(BeFalse())
+			ids[id] = true
+
+			parsed, err := xsnowflake.DefaultLayout().Parse(id)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(parsed.NodeId).Should(Equal(nodeId))
+		}
+
+		leases := clientLeases()
+		Expect(leases).Should(HaveLen(1))
+		Expect(leases[0].GetNodeId()).Should(Equal(nodeId))
+		Expect(leases[0].GetOwner()).Should(Equal("orders"))
+	})
+
+	It("gives every generator a node id of its own and gives it back on close", func() {
+		var generators []*uuidClient.LeasedGenerator
+		nodeIds := map[int64]bool{uuidService.NodeId(): true}
+		for i := 0; i < 3; i++ {
+			generator, err := uuidClient.NewLeased(uuidv1.NewUuidServiceClient(conn), newConfig())
+			Expect(err).ShouldNot(HaveOccurred())
+			generators = append(generators, generator)
+
+			nodeId, _ := generator.NodeId()
+			Expect(nodeIds).ShouldNot(HaveKey(nodeId))
+			nodeIds[nodeId] = true
+		}
+
+		var (
+			wg  sync.WaitGroup
+			mu  sync.Mutex
+			ids = map[int64]bool{}
+		)
+		for _, generator := range generators {
+			wg.Add(1)
+			go func(generator *uuidClient.LeasedGenerator) {
+				defer GinkgoRecover()
+				defer wg.Done()
+
+				for j := 0; j < 5000; j++ {
+					id, err := generator.NextId()
+					Expect(err).ShouldNot(HaveOccurred())
+
+					mu.Lock()
+					Expect(ids[id]).Should(BeFalse())
+					ids[id] = true
+					mu.Unlock()
+				}
+			}(generator)
+		}
+		wg.Wait()
+		Expect(ids).Should(HaveLen(15000))
+
+		Expect(clientLeases()).Should(HaveLen(3))
+		for _, generator := range generators {
+			Expect(generator.Close()).Should(Succeed())
+		}
+		Expect(clientLeases()).Should(BeEmpty())
+
+		_, err := generators[0].NextId()
+		Expect(err).Should(MatchError(uuidClient.ErrLeaseExpired))
+	})
+
+	It("stops generating once the lease is lost and leases another node id", func() {
+		generator, err := uuidClient.NewLeased(uuidv1.NewUuidServiceClient(conn), newConfig())
+		Expect(err).ShouldNot(HaveOccurred())
+		defer generator.Close()
+
+		lost, _ := generator.NodeId()
+		// someone else holds the node id now
+		Expect(redisServer.Set("{jupiter.uuid.node}.lease."+strconv.FormatInt(lost, 10), "someone else")).Should(Succeed())
+
+		Eventually(func() int64 {
+			nodeId, _ := generator.NodeId()
+			return nodeId
+		}).ShouldNot(Equal(lost))
+
+		nodeId, valid := generator.NodeId()
+		Expect(valid).Should(BeTrue())
+		id, err := generator.NextId()
+		Expect(err).ShouldNot(HaveOccurred())
+		parsed, err := xsnowflake.DefaultLayout().Parse(id)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(parsed.NodeId).Should(Equal(nodeId))
+	})
+
+	It("stops generating while the server can't be reached", func() {
+		generator, err := uuidClient.NewLeased(uuidv1.NewUuidServiceClient(conn), newConfig())
+		Expect(err).ShouldNot(HaveOccurred())
+		defer generator.Close()
+
+		server.Stop()
+		Eventually(func() error {
+			_, err := generator.NextId()
+			return err
+		}).Should(MatchError(uuidClient.ErrLeaseExpired))
+	})
+
+	It("reports the errors the server answered with", func() {
+		config := newConfig()
+		config.Generator = "missing"
+		_, err := uuidClient.NewLeased(uuidv1.NewUuidServiceClient(conn), config)
+
+		var serverErr *uuidClient.Error
+		Expect(errors.As(err, &serverErr)).Should(BeTrue())
+		Expect(serverErr.Code).Should(Equal(uuidv1.ErrorCode_ERROR_CODE_UNKNOWN_GENERATOR))
+	})
+	Context("with high-water marks", func() {
+		BeforeEach(func() {
+			highWaterMarkStore = service.HighWaterMarkRedis
+		})
+
+		It("hands a node id to a client whose clock is behind only past the ids of the previous holder", func() {
+			first, err := uuidClient.NewLeased(uuidv1.NewUuidServiceClient(conn), newConfig())
+			Expect(err).ShouldNot(HaveOccurred())
+			nodeId, _ := first.NodeId()
+
+			ids := map[int64]bool{}
+			var last int64
+			for i := 0; i < 10000; i++ {
+				id, err := first.NextId()
+				Expect(err).ShouldNot(HaveOccurred())
+				ids[id] = true
+				last = id
+			}
+			Expect(first.Close()).Should(Succeed())
+
+			// the node id moves to a host whose clock is 300ms behind
+			config := newConfig()
+			config.Now = func() time.Time {
+				return time.Now().Add(-300 * time.Millisecond)
+			}
+			second, err := uuidClient.NewLeased(uuidv1.NewUuidServiceClient(conn), config)
+			Expect(err).ShouldNot(HaveOccurred())
+			defer second.Close()
+			secondNodeId, _ := second.NodeId()
+			Expect(secondNodeId).Should(Equal(nodeId))
+
+			_, err = second.NextId()
+			Expect(err).Should(MatchError(xsnowflake.ErrClockRollback))
+
+			Eventually(func() error {
+				_, err := second.NextId()
+				return err
+			}, 2*time.Second, 10*time.Millisecond).Should(Succeed())
+			for i := 0; i < 10000; i++ {
+				id, err := second.NextId()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(ids[id]).Should(BeFalse())
+				Expect(id).Should(BeNumerically(">", last))
+			}
+		})
+
+		It("keeps the mark at the end of the lease until its holder releases it", func() {
+			res, err := uuidService.LeaseNodeId(ctx, &uuidv1.LeaseNodeIdRequest{Owner: "orders"})
+			Expect(err).ShouldNot(HaveOccurred())
+			nodeId := res.GetData().GetNodeId()
+			Expect(res.GetData().GetSince()).Should(BeZero())
+
+			mark, err := redisCli.GetHighWaterMark(ctx, nodeId)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(time.UnixMilli(mark)).Should(BeTemporally("~", time.Now().Add(600*time.Millisecond), 100*time.Millisecond))
+
+			// a lease that is not the one of the holder leaves the mark alone
+			_, err = uuidService.ReleaseNodeLease(ctx, &uuidv1.ReleaseNodeLeaseRequest{NodeId: nodeId, Lease: "client/orders/stale"})
+			Expect(err).ShouldNot(HaveOccurred())
+			stale, err := redisCli.GetHighWaterMark(ctx, nodeId)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(stale).Should(Equal(mark))
+
+			_, err = uuidService.ReleaseNodeLease(ctx, &uuidv1.ReleaseNodeLeaseRequest{NodeId: nodeId, Lease: res.GetData().GetLease()})
+			Expect(err).ShouldNot(HaveOccurred())
+			released, err := redisCli.GetHighWaterMark(ctx, nodeId)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(time.UnixMilli(released)).Should(BeTemporally("~", time.Now(), 100*time.Millisecond))
+
+			// the next holder generates past the mark
+			res, err = uuidService.LeaseNodeId(ctx, &uuidv1.LeaseNodeIdRequest{Owner: "orders"})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.GetData().GetNodeId()).Should(Equal(nodeId))
+			Expect(res.GetData().GetSince()).Should(Equal(released + 100))
+		})
+
+		It("keeps the mark within the end of the lease whatever last id the holder releases it with", func() {
+			res, err := uuidService.LeaseNodeId(ctx, &uuidv1.LeaseNodeIdRequest{Owner: "orders"})
+			Expect(err).ShouldNot(HaveOccurred())
+			nodeId := res.GetData().GetNodeId()
+
+			_, err = uuidService.ReleaseNodeLease(ctx, &uuidv1.ReleaseNodeLeaseRequest{NodeId: nodeId, Lease: res.GetData().GetLease(),
+				Last: time.Now().Add(time.Hour).UnixMilli()})
+			Expect(err).ShouldNot(HaveOccurred())
+			released, err := redisCli.GetHighWaterMark(ctx, nodeId)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(time.UnixMilli(released)).Should(BeTemporally("~", time.Now().Add(600*time.Millisecond), 100*time.Millisecond))
+
+			// the node id can be generated with again once the lease would have ended
+			generator, err := uuidClient.NewLeased(uuidv1.NewUuidServiceClient(conn), newConfig())
+			Expect(err).ShouldNot(HaveOccurred())
+			defer generator.Close()
+			Eventually(func() error {
+				_, err := generator.NextId()
+				return err
+			}, 2*time.Second, 50*time.Millisecond).Should(Succeed())
+		})
+	})
+})
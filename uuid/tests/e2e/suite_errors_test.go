@@ -0,0 +1,201 @@
+package e2e
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+
+	uuidv1 "github.com/douyu/jupiter-examples/uuid/gen/api/go/uuid/v1"
+	etcdMocks "github.com/douyu/jupiter-examples/uuid/gen/mocks/etcd"
+	mocks "github.com/douyu/jupiter-examples/uuid/gen/mocks/redis"
+	"github.com/douyu/jupiter-examples/uuid/internal/app/uuidserver/controller"
+	"github.com/douyu/jupiter-examples/uuid/internal/app/uuidserver/service"
+	"github.com/douyu/jupiter/pkg/util/xerror"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+var _ = Describe("error codes", func() {
+	var (
+		uuidService *service.Uuid
+		grpcServer  *grpc.Server
+		conn        *grpc.ClientConn
+		client      uuidv1.UuidServiceClient
+		httpServer  *httptest.Server
+		ctx         = context.Background()
+	)
+
+	BeforeEach(func() {
+		var err error
+		uuidService, err = CreateUuidService(&mocks.RedisInterface{}, &etcdMocks.EtcdInterface{})
+		Expect(err).ShouldNot(HaveOccurred())
+		uuidGrpc := controller.NewUUuidGrpcController(uuidService)
+
+		lis := bufconn.Listen(1 << 20)
+		grpcServer = grpc.NewServer()
+		uuidv1.RegisterUuidServiceServer(grpcServer, uuidGrpc)
+		go grpcServer.Serve(lis)
+
+		conn, err = grpc.Dial("bufnet", grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		Expect(err).ShouldNot(HaveOccurred())
+		client = uuidv1.NewUuidServiceClient(conn)
+
+		uuidHTTP, err := controller.NewUuidHTTPController(uuidGrpc)
+		Expect(err).ShouldNot(HaveOccurred())
+		httpServer = httptest.NewServer(uuidHTTP)
+	})
+
+	AfterEach(func() {
+		httpServer.Close()
+		conn.Close()
+		grpcServer.Stop()
+		uuidService.Close()
+	})
+
+	// errorDetail the ErrorCode in the details of the status of err
+	errorDetail := func(err error) uuidv1.ErrorCode {
+		for _, detail := range status.Convert(err).Details() {
+			if detail, ok := detail.(*uuidv1.ErrorDetail); ok {
+				return detail.GetCode()
+			}
+		}
+		return uuidv1.ErrorCode_ERROR_CODE_UNSPECIFIED
+	}
+
+	get := func(path string) (int, httpResponse) {
+		res, err := http.Get(httpServer.URL + path)
+		Expect(err).ShouldNot(HaveOccurred())
+		defer res.Body.Close()
+
+		var body httpResponse
+		Expect(json.NewDecoder(res.Body).Decode(&body)).Should(Succeed())
+		return res.StatusCode, body
+	}
+
+	DescribeTable("answers a failed rpc with its ErrorCode, the grpc code and the http status of it",
+		func(call func(client uuidv1.UuidServiceClient) error, path string, code uuidv1.ErrorCode, grpcCode codes.Code, httpStatus int) {
+			err := call(client)
+			Expect(status.Code(err)).Should(Equal(grpcCode))
+			Expect(errorDetail(err)).Should(Equal(code))
+
+			answered, res := get(path)
+			Expect(answered).Should(Equal(httpStatus))
+			Expect(res.Error).Should(BeEquivalentTo(code))
+			Expect(res.Msg).Should(Equal(status.Convert(err).Message()))
+			Expect(string(res.Data)).Should(Equal("null"))
+		},
+		Entry("an unknown generator", func(client uuidv1.UuidServiceClient) error {
+			_, err := client.GetUuidBySnowflake(ctx, &uuidv1.GetUuidBySnowflakeRequest{Generator: "missing"})
+			return err
+		}, "/snowflake_uuid?generator=missing", uuidv1.ErrorCode_ERROR_CODE_UNKNOWN_GENERATOR, codes.NotFound, http.StatusNotFound),
+		Entry("an unknown format", func(client uuidv1.UuidServiceClient) error {
+			_, err := client.GetUuidBySnowflake(ctx, &uuidv1.GetUuidBySnowflakeRequest{Format: "roman"})
+			return err
+		}, "/snowflake_uuid?format=roman", uuidv1.ErrorCode_ERROR_CODE_UNKNOWN_FORMAT, codes.InvalidArgument, http.StatusBadRequest),
+		Entry("an oversized batch", func(client uuidv1.UuidServiceClient) error {
+			_, err := client.GetUuidBySnowflakeBatch(ctx, &uuidv1.GetUuidBySnowflakeBatchRequest{Count: 10001})
+			return err
+		}, "/snowflake_uuid_batch?count=10001", uuidv1.ErrorCode_ERROR_CODE_INVALID_BATCH_COUNT, codes.InvalidArgument, http.StatusBadRequest),
+		Entry("an id that is not a snowflake", func(client uuidv1.UuidServiceClient) error {
+			_, err := client.ParseSnowflake(ctx, &uuidv1.ParseSnowflakeRequest{Uuid: "abc"})
+			return err
+		}, "/snowflake_uuid/parse?uuid=abc", uuidv1.ErrorCode_ERROR_CODE_INVALID_SNOWFLAKE, codes.InvalidArgument, http.StatusBadRequest),
+		Entry("a reversed time window", func(client uuidv1.UuidServiceClient) error {
+			_, err := client.GetSnowflakeRange(ctx, &uuidv1.GetSnowflakeRangeRequest{StartTime: 1700000000999, EndTime: 1700000000000})
+			return err
+		}, "/snowflake_uuid/range?start=1700000000999&end=1700000000000", uuidv1.ErrorCode_ERROR_CODE_INVALID_TIME_WINDOW, codes.InvalidArgument, http.StatusBadRequest),
+		Entry("an unknown namespace", func(client uuidv1.UuidServiceClient) error {
+			_, err := client.GetUuidByName(ctx, &uuidv1.GetUuidByNameRequest{Namespace: "nope", Name: "example.com"})
+			return err
+		}, "/name_uuid?namespace=nope&name=example.com", uuidv1.ErrorCode_ERROR_CODE_UNKNOWN_NAMESPACE, codes.InvalidArgument, http.StatusBadRequest),
+		Entry("a uuid version that is not name-based", func(client uuidv1.UuidServiceClient) error {
+			_, err := client.GetUuidByName(ctx, &uuidv1.GetUuidByNameRequest{Version: 4, Namespace: "dns", Name: "example.com"})
+			return err
+		}, "/name_uuid?version=4&namespace=dns&name=example.com", uuidv1.ErrorCode_ERROR_CODE_INVALID_UUID_VERSION, codes.InvalidArgument, http.StatusBadRequest),
+		Entry("a malformed public id", func(client uuidv1.UuidServiceClient) error {
+			_, err := client.ParsePublicId(ctx, &uuidv1.ParsePublicIdRequest{Id: "nope"})
+			return err
+		}, "/public_id/parse?id=nope", uuidv1.ErrorCode_ERROR_CODE_INVALID_PUBLIC_ID, codes.InvalidArgument, http.StatusBadRequest),
+		Entry("a prefix that is not registered", func(client uuidv1.UuidServiceClient) error {
+			_, err := client.GetPublicId(ctx, &uuidv1.GetPublicIdRequest{Prefix: "zzz"})
+			return err
+		}, "/public_id?prefix=zzz", uuidv1.ErrorCode_ERROR_CODE_UNKNOWN_PUBLIC_ID_PREFIX, codes.NotFound, http.StatusNotFound),
+		Entry("segment ids while they are off", func(client uuidv1.UuidServiceClient) error {
+			_, err := client.GetSegmentId(ctx, &uuidv1.GetSegmentIdRequest{BizTag: "orders"})
+			return err
+		}, "/segment_id?biz_tag=orders", uuidv1.ErrorCode_ERROR_CODE_SEGMENT_DISABLED, codes.FailedPrecondition, http.StatusBadRequest),
+		Entry("an unknown sequence", func(client uuidv1.UuidServiceClient) error {
+			_, err := client.GetSequence(ctx, &uuidv1.GetSequenceRequest{Key: "missing"})
+			return err
+		}, "/sequence?key=missing", uuidv1.ErrorCode_ERROR_CODE_UNKNOWN_SEQUENCE, codes.NotFound, http.StatusNotFound),
+		Entry("an idempotency key without store", func(client uuidv1.UuidServiceClient) error {
+			_, err := client.GetUuidBySnowflake(ctx, &uuidv1.GetUuidBySnowflakeRequest{IdempotencyKey: "order-1"})
+			return err
+		}, "/snowflake_uuid?idempotency_key=order-1", uuidv1.ErrorCode_ERROR_CODE_IDEMPOTENCY_DISABLED, codes.FailedPrecondition, http.StatusBadRequest),
+	)
+
+	It("answers the rpcs that have no http route with the ErrorCode of the service", func() {
+		_, err := client.LeaseNodeId(ctx, &uuidv1.LeaseNodeIdRequest{Owner: "worker-1"})
+		Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		Expect(errorDetail(err)).Should(Equal(uuidv1.ErrorCode_ERROR_CODE_NODE_LEASES_DISABLED))
+
+		stream, err := client.StreamUuidBySnowflake(ctx)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(stream.Send(&uuidv1.StreamUuidBySnowflakeRequest{ChunkSize: 10001, Credit: 1})).Should(Succeed())
+		_, err = stream.Recv()
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		Expect(errorDetail(err)).Should(Equal(uuidv1.ErrorCode_ERROR_CODE_INVALID_BATCH_COUNT))
+	})
+
+	It("answers the errors of the gateway with the ErrorCodes of their own", func() {
+		answered, res := get("/snowflake_uuid_batch?count=many")
+		Expect(answered).Should(Equal(http.StatusBadRequest))
+		Expect(res.Error).Should(BeEquivalentTo(uuidv1.ErrorCode_ERROR_CODE_INVALID_REQUEST))
+		Expect(res.Msg).ShouldNot(BeEmpty())
+
+		answered, res = get("/no_such_route")
+		Expect(answered).Should(Equal(http.StatusNotFound))
+		Expect(res.Error).Should(BeEquivalentTo(uuidv1.ErrorCode_ERROR_CODE_UNKNOWN_ROUTE))
+
+		answered, res = get("/snowflake_uuid/unknown")
+		Expect(answered).Should(Equal(http.StatusNotFound))
+		Expect(res.Error).Should(BeEquivalentTo(uuidv1.ErrorCode_ERROR_CODE_UNKNOWN_ROUTE))
+	})
+
+	It("maps the errors that are not of the service to ERROR_CODE_INTERNAL", func() {
+		code, grpcCode := service.ErrorCode(service.ErrUnknownGenerator)
+		Expect(code).Should(Equal(uuidv1.ErrorCode_ERROR_CODE_UNKNOWN_GENERATOR))
+		Expect(grpcCode).Should(Equal(codes.NotFound))
+
+		code, grpcCode = service.ErrorCode(fmt.Errorf("lease: %w", service.ErrNodeLeaseLost))
+		Expect(code).Should(Equal(uuidv1.ErrorCode_ERROR_CODE_NODE_LEASE_LOST))
+		Expect(grpcCode).Should(Equal(codes.NotFound))
+
+		code, grpcCode = service.ErrorCode(errors.New("redis down"))
+		Expect(code).Should(Equal(uuidv1.ErrorCode_ERROR_CODE_INTERNAL))
+		Expect(grpcCode).Should(Equal(codes.Internal))
+
+		code, grpcCode = service.ErrorCode(fmt.Errorf("stream: %w", context.Canceled))
+		Expect(code).Should(Equal(uuidv1.ErrorCode_ERROR_CODE_INTERNAL))
+		Expect(grpcCode).Should(Equal(codes.Canceled))
+
+		code, grpcCode = service.ErrorCode(fmt.Errorf("send: %w", status.Error(codes.Unavailable, "transport is closing")))
+		Expect(code).Should(Equal(uuidv1.ErrorCode_ERROR_CODE_INTERNAL))
+		Expect(grpcCode).Should(Equal(codes.Unavailable))
+
+		code, grpcCode = service.ErrorCode(xerror.NotFound)
+		Expect(code).Should(Equal(uuidv1.ErrorCode_ERROR_CODE_INTERNAL))
+		Expect(grpcCode).Should(Equal(codes.Internal))
+	})
+})
@@ -0,0 +1,241 @@
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	uuidv1 "github.com/douyu/jupiter-examples/uuid/gen/api/go/uuid/v1"
+	redisMocks "github.com/douyu/jupiter-examples/uuid/gen/mocks/redis"
+	"github.com/douyu/jupiter-examples/uuid/internal/app/uuidserver/service"
+	"github.com/douyu/jupiter-examples/uuid/internal/pkg/etcd"
+	"github.com/douyu/jupiter/pkg/client/etcdv3"
+	"github.com/douyu/jupiter/pkg/conf"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/server/v3/embed"
+)
+
+var _ = Describe("etcd node id lease", Ordered, func() {
+	var (
+		server *embed.Etcd
+		client *clientv3.Client
+		cli    *etcd.Etcd
+		ctx    = context.Background()
+	)
+
+	BeforeAll(func() {
+		cfg := embed.NewConfig()
+		cfg.Dir = GinkgoT().TempDir()
+		cfg.LogLevel = "error"
+		clientURL, peerURL := localURL(), localURL()
+		cfg.ListenClientUrls, cfg.AdvertiseClientUrls = []url.URL{clientURL}, []url.URL{clientURL}
+		cfg.ListenPeerUrls, cfg.AdvertisePeerUrls = []url.URL{peerURL}, []url.URL{peerURL}
+		cfg.InitialCluster = cfg.InitialClusterFromName(cfg.Name)
+
+		var err error
+		server, err = embed.StartEtcd(cfg)
+		Expect(err).ShouldNot(HaveOccurred())
+		Eventually(server.Server.ReadyNotify(), 10*time.Second).Should(BeClosed())
+
+		client, err = clientv3.New(clientv3.Config{Endpoints: []string{clientURL.String()}, DialTimeout: 5 * time.Second})
+		Expect(err).ShouldNot(HaveOccurred())
+		cli = &etcd.Etcd{Client: &etcdv3.Client{Client: client}}
+	})
+
+	AfterAll(func() {
+		client.Close()
+		server.Close()
+	})
+
+	AfterEach(func() {
+		_, err := client.Delete(ctx, "/jupiter/uuid/node/", clientv3.WithPrefix())
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("claims the lowest free node id and reports the pool exhausted", func() {
+		first, firstLease, err := cli.AcquireNodeId(ctx, 2, "a", 5*time.Second)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(first).Should(Equal(int64(1)))
+
+		second, _, err := cli.AcquireNodeId(ctx, 2, "b", 5*time.Second)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(second).Should(Equal(int64(2)))
+
+		_, _, err = cli.AcquireNodeId(ctx, 2, "c", 5*time.Second)
+		Expect(err).Should(MatchError(etcd.ErrNodeIdExhausted))
+
+		Expect(cli.ReleaseNodeId(ctx, firstLease)).Should(Succeed())
+		nodeId, _, err := cli.AcquireNodeId(ctx, 2, "c", 5*time.Second)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(nodeId).Should(Equal(int64(1)))
+	})
+
+	It("frees the node id once the lease expires", func() {
+		_, _, err := cli.AcquireNodeId(ctx, 1, "a", time.Second)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Eventually(func() error {
+			_, _, err := cli.AcquireNodeId(ctx, 1, "b", time.Second)
+			return err
+		}, 10*time.Second, 200*time.Millisecond).Should(Succeed())
+	})
+
+	It("keeps the high-water mark outside of the node id claims", func() {
+		mark, err := cli.GetHighWaterMark(ctx, 1)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(mark).Should(BeZero())
+
+		Expect(cli.SetHighWaterMark(ctx, 1, 1700000000000)).Should(Succeed())
+		mark, err = cli.GetHighWaterMark(ctx, 1)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(mark).Should(Equal(int64(1700000000000)))
+
+		// the mark doesn't take node id 1
+		nodeId, _, err := cli.AcquireNodeId(ctx, 1, "a", 5*time.Second)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(nodeId).Should(Equal(int64(1)))
+	})
+
+	It("renews, releases and lists the claims by node id and owner", func() {
+		nodeId, _, err := cli.AcquireNodeId(ctx, 1023, "a", 5*time.Second)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, err = cli.RenewNodeClaim(ctx, nodeId, "b")
+		Expect(err).Should(MatchError(etcd.ErrNodeClaimLost))
+		ttl, err := cli.RenewNodeClaim(ctx, nodeId, "a")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(ttl).Should(Equal(5 * time.Second))
+
+		claims, err := cli.ListNodeClaims(ctx)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(claims).Should(HaveLen(1))
+		Expect(claims[0].NodeId).Should(Equal(nodeId))
+		Expect(claims[0].Owner).Should(Equal("a"))
+		Expect(claims[0].TTL).Should(BeNumerically("~", 5*time.Second, time.Second))
+
+		Expect(cli.ReleaseNodeClaim(ctx, nodeId, "b")).Should(Succeed())
+		_, err = cli.RenewNodeClaim(ctx, nodeId, "a")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(cli.ReleaseNodeClaim(ctx, nodeId, "a")).Should(Succeed())
+		Expect(cli.ReleaseNodeClaim(ctx, nodeId, "a")).Should(Succeed())
+		_, err = cli.RenewNodeClaim(ctx, nodeId, "a")
+		Expect(err).Should(MatchError(etcd.ErrNodeClaimLost))
+		claims, err = cli.ListNodeClaims(ctx)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(claims).Should(BeEmpty())
+	})
+
+	Context("uuid service", func() {
+		BeforeEach(func() {
+			conf.Set("jupiter.server.uuid.enableEtcd", true)
+			conf.Set("jupiter.server.uuid.nodeLeaseTTL", "3s")
+		})
+
+		AfterEach(func() {
+			conf.Set("jupiter.server.uuid.enableEtcd", false)
+			conf.Set("jupiter.server.uuid.nodeLeaseTTL", "30s")
+		})
+
+		It("keeps the claim alive and gives it back on close", func() {
+			uuidService, err := CreateUuidService(&redisMocks.RedisInterface{}, cli)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			res, err := client.Get(ctx, "/jupiter/uuid/node/1")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.Kvs).Should(HaveLen(1))
+
+			// outlive the ttl, the keep alive must hold on to the node id
+			time.Sleep(4 * time.Second)
+			_, err = uuidService.GetUuidBySnowflake(ctx, &uuidv1.GetUuidBySnowflakeRequest{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			uuidService.Close()
+			res, err = client.Get(ctx, "/jupiter/uuid/node/1")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.Kvs).Should(BeEmpty())
+		})
+
+		It("claims a node id again once the lease is gone", func() {
+			uuidService, err := CreateUuidService(&redisMocks.RedisInterface{}, cli)
+			Expect(err).ShouldNot(HaveOccurred())
+			defer uuidService.Close()
+
+			before, err := uuidService.GetUuidBySnowflake(ctx, &uuidv1.GetUuidBySnowflakeRequest{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			res, err := client.Get(ctx, "/jupiter/uuid/node/1")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.Kvs).Should(HaveLen(1))
+			lost := res.Kvs[0].Lease
+			// the lease is gone as after an etcd partition longer than its ttl
+			_, err = client.Revoke(ctx, clientv3.LeaseID(lost))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Eventually(func() int64 {
+				res, err := client.Get(ctx, "/jupiter/uuid/node/1")
+				Expect(err).ShouldNot(HaveOccurred())
+				if len(res.Kvs) == 0 {
+					return lost
+				}
+				return res.Kvs[0].Lease
+			}, 5*time.Second).ShouldNot(Equal(lost))
+
+			var after *uuidv1.GetUuidBySnowflakeResponse
+			Eventually(func() error {
+				after, err = uuidService.GetUuidBySnowflake(ctx, &uuidv1.GetUuidBySnowflakeRequest{})
+				return err
+			}, 5*time.Second).Should(Succeed())
+			Expect(after.Data.Id).Should(BeNumerically(">", before.Data.Id))
+			Expect(uuidService.NodeId()).Should(Equal(int64(1)))
+		})
+
+		It("leases node ids to clients under leases of their own", func() {
+			conf.Set("jupiter.server.uuid.enableNodeLeases", true)
+			uuidService, err := CreateUuidService(&redisMocks.RedisInterface{}, cli)
+			conf.Set("jupiter.server.uuid.enableNodeLeases", false)
+			Expect(err).ShouldNot(HaveOccurred())
+			defer uuidService.Close()
+
+			res, err := uuidService.LeaseNodeId(ctx, &uuidv1.LeaseNodeIdRequest{Owner: "orders"})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.Data.NodeId).Should(Equal(int64(2)))
+			Expect(res.Data.Ttl).Should(Equal((3 * time.Second).Milliseconds()))
+
+			// outlive the ttl with the renewals of the client
+			for i := 0; i < 4; i++ {
+				time.Sleep(time.Second)
+				renewed, err := uuidService.RenewNodeLease(ctx, &uuidv1.RenewNodeLeaseRequest{NodeId: 2, Lease: res.Data.Lease})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(renewed.Data.Ttl).Should(Equal((3 * time.Second).Milliseconds()))
+			}
+
+			list, err := uuidService.ListNodeLeases(ctx, &uuidv1.ListNodeLeasesRequest{})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(list.Data.Leases).Should(HaveLen(2))
+			Expect(list.Data.Leases[0].Self).Should(BeTrue())
+			Expect(list.Data.Leases[1].Owner).Should(Equal("orders"))
+			Expect(list.Data.Leases[1].Client).Should(BeTrue())
+
+			_, err = uuidService.ReleaseNodeLease(ctx, &uuidv1.ReleaseNodeLeaseRequest{NodeId: 2, Lease: res.Data.Lease})
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = uuidService.RenewNodeLease(ctx, &uuidv1.RenewNodeLeaseRequest{NodeId: 2, Lease: res.Data.Lease})
+			Expect(err).Should(Equal(service.ErrNodeLeaseLost))
+		})
+	})
+})
+
+// localURL picks a free local port for the embedded etcd
+func localURL() url.URL {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).ShouldNot(HaveOccurred())
+	defer l.Close()
+
+	u, err := url.Parse(fmt.Sprintf("http://%s", l.Addr().String()))
+	Expect(err).ShouldNot(HaveOccurred())
+	return *u
+}
@@ -0,0 +1,192 @@
+package e2e
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	uuidv1 "github.com/douyu/jupiter-examples/uuid/gen/api/go/uuid/v1"
+	etcdMocks "github.com/douyu/jupiter-examples/uuid/gen/mocks/etcd"
+	mocks "github.com/douyu/jupiter-examples/uuid/gen/mocks/redis"
+	"github.com/douyu/jupiter-examples/uuid/internal/app/uuidserver/controller"
+	"github.com/douyu/jupiter-examples/uuid/internal/app/uuidserver/service"
+	xsnowflake "github.com/douyu/jupiter-examples/uuid/pkg/snowflake"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// httpResponse the envelope every route answers with, data as the rpc put it
+type httpResponse struct {
+	Error uint32          `json:"error"`
+	Msg   string          `json:"msg"`
+	Data  json.RawMessage `json:"data"`
+}
+
+var _ = Describe("http gateway", func() {
+	var (
+		uuidService *service.Uuid
+		server      *httptest.Server
+	)
+
+	BeforeEach(func() {
+		var err error
+		uuidService, err = CreateUuidService(&mocks.RedisInterface{}, &etcdMocks.EtcdInterface{})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		uuidHTTP, err := controller.NewUuidHTTPController(controller.NewUUuidGrpcController(uuidService))
+		Expect(err).ShouldNot(HaveOccurred())
+		server = httptest.NewServer(uuidHTTP)
+	})
+
+	AfterEach(func() {
+		server.Close()
+		uuidService.Close()
+	})
+
+	do := func(req *http.Request, data interface{}) (int, httpResponse) {
+		res, err := http.DefaultClient.Do(req)
+		Expect(err).ShouldNot(HaveOccurred())
+		defer res.Body.Close()
+
+		var body httpResponse
+		Expect(json.NewDecoder(res.Body).Decode(&body)).Should(Succeed())
+		if data != nil && body.Error == 0 {
+			Expect(json.Unmarshal(body.Data, data)).Should(Succeed())
+		}
+		return res.StatusCode, body
+	}
+
+	get := func(path string, data interface{}) (int, httpResponse) {
+		req, err := http.NewRequest(http.MethodGet, server.URL+path, nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		return do(req, data)
+	}
+
+	It("binds the query params of the annotated routes and answers in protojson", func() {
+		var data struct {
+			Uuid string `json:"uuid"`
+			// uint64 is a string in protojson
+			Id string `json:"id"`
+		}
+		status, res := get("/snowflake_uuid?format=hex", &data)
+		Expect(status).Should(Equal(http.StatusOK))
+		Expect(res.Error).Should(BeZero())
+		Expect(res.Msg).Should(Equal("success"))
+
+		id, err := strconv.ParseInt(data.Id, 10, 64)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(data.Uuid).Should(Equal(strconv.FormatInt(id, 16)))
+
+		var parsed struct {
+			NodeId string `json:"node_id"`
+		}
+		_, res = get("/snowflake_uuid/parse?uuid="+data.Id, &parsed)
+		Expect(res.Error).Should(BeZero())
+		Expect(parsed.NodeId).Should(Equal(strconv.FormatInt(uuidService.NodeId(), 10)))
+
+		var window struct {
+			MinUuid string `json:"min_uuid"`
+			MaxUuid string `json:"max_uuid"`
+		}
+		// start and end as the handlers written by hand bound them
+		_, res = get("/snowflake_uuid/range?start=1700000000000&end=1700000000999", &window)
+		Expect(res.Error).Should(BeZero())
+		min, max, err := xsnowflake.DefaultLayout().Range(time.UnixMilli(1700000000000), time.UnixMilli(1700000000999))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(window.MinUuid).Should(Equal(strconv.FormatInt(min, 10)))
+		Expect(window.MaxUuid).Should(Equal(strconv.FormatInt(max, 10)))
+	})
+
+	It("binds the query params of the handlers written by hand besides the field names", func() {
+		var window uuidv1.GetSnowflakeRangeRequest
+		Expect(runtime.PopulateQueryParameters(&window, url.Values{"start_time": {"1"}, "end": {"2"}}, utilities.NewDoubleArray(nil))).Should(Succeed())
+		Expect(window.GetStartTime()).Should(BeEquivalentTo(1))
+		Expect(window.GetEndTime()).Should(BeEquivalentTo(2))
+
+		var decode uuidv1.DecodeObfuscatedIdRequest
+		Expect(runtime.PopulateQueryParameters(&decode, url.Values{"id": {"1abc"}}, utilities.NewDoubleArray(nil))).Should(Succeed())
+		Expect(decode.GetObfuscatedId()).Should(Equal("1abc"))
+	})
+
+	It("lists the routes of the annotated rpcs for the http server to mount", func() {
+		uuidHTTP, err := controller.NewUuidHTTPController(controller.NewUUuidGrpcController(uuidService))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		routes := uuidHTTP.Routes()
+		Expect(routes).Should(ContainElements(
+			controller.HTTPRoute{Method: http.MethodGet, Path: "/snowflake_uuid"},
+			controller.HTTPRoute{Method: http.MethodGet, Path: "/obfuscated_id/decode"},
+			controller.HTTPRoute{Method: http.MethodPost, Path: "/name_uuid_batch"},
+		))
+		Expect(routes).ShouldNot(ContainElement(HaveField("Path", "/*")))
+		// the leases of the node id pool are grpc only, their owners are not for the public gateway
+		Expect(routes).ShouldNot(ContainElement(HaveField("Path", "/admin/node_leases")))
+		for _, route := range routes {
+			// every route is answered by an rpc rather than the routing error of the gateway
+			req := httptest.NewRequest(route.Method, route.Path, nil)
+			rec := httptest.NewRecorder()
+			uuidHTTP.ServeHTTP(rec, req)
+
+			var body httpResponse
+			Expect(json.NewDecoder(rec.Body).Decode(&body)).Should(Succeed())
+			Expect(body.Error).ShouldNot(BeEquivalentTo(uuidv1.ErrorCode_ERROR_CODE_UNKNOWN_ROUTE), route.Path)
+		}
+	})
+
+	It("binds the body of the routes annotated with one", func() {
+		var single struct {
+			Uuid string `json:"uuid"`
+		}
+		_, res := get("/name_uuid?namespace=dns&name=example.com", &single)
+		Expect(res.Error).Should(BeZero())
+
+		req, err := http.NewRequest(http.MethodPost, server.URL+"/name_uuid_batch", strings.NewReader(`{"namespace":"dns","names":["example.com","example.org"]}`))
+		Expect(err).ShouldNot(HaveOccurred())
+		req.Header.Set("Content-Type", "application/json")
+		var batch struct {
+			Uuids []string `json:"uuids"`
+		}
+		_, res = do(req, &batch)
+		Expect(res.Error).Should(BeZero())
+		Expect(batch.Uuids).Should(HaveLen(2))
+		Expect(batch.Uuids[0]).Should(Equal(single.Uuid))
+	})
+
+	It("answers the errors of the rpcs with the http status of their grpc code in the same envelope", func() {
+		status, res := get("/snowflake_uuid?generator=missing", nil)
+		Expect(status).Should(Equal(http.StatusNotFound))
+		Expect(res.Error).Should(BeEquivalentTo(uuidv1.ErrorCode_ERROR_CODE_UNKNOWN_GENERATOR))
+		Expect(res.Msg).Should(ContainSubstring("missing"))
+
+		status, res = get("/snowflake_uuid_batch?count=many", nil)
+		Expect(status).Should(Equal(http.StatusBadRequest))
+		Expect(res.Error).Should(BeEquivalentTo(uuidv1.ErrorCode_ERROR_CODE_INVALID_REQUEST))
+
+		status, res = get("/no_such_route", nil)
+		Expect(status).Should(Equal(http.StatusNotFound))
+		Expect(res.Error).Should(BeEquivalentTo(uuidv1.ErrorCode_ERROR_CODE_UNKNOWN_ROUTE))
+	})
+
+	It("passes the Idempotency-Key header on to the rpcs", func() {
+		var data struct {
+			Uuids []string `json:"uuids"`
+		}
+		_, res := get("/snowflake_uuid_batch?count=2", &data)
+		Expect(res.Error).Should(BeZero())
+		Expect(data.Uuids).Should(HaveLen(2))
+
+		// the server has no idempotency store, so a key is refused
+		req, err := http.NewRequest(http.MethodGet, server.URL+"/snowflake_uuid_batch?count=2", nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		req.Header.Set("Idempotency-Key", "order-1")
+		status, res := do(req, nil)
+		Expect(status).Should(Equal(http.StatusBadRequest))
+		Expect(res.Error).Should(BeEquivalentTo(uuidv1.ErrorCode_ERROR_CODE_IDEMPOTENCY_DISABLED))
+	})
+})
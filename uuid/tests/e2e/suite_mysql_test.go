@@ -0,0 +1,216 @@
+package e2e
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync"
+	"time"
+
+	uuidv1 "github.com/douyu/jupiter-examples/uuid/gen/api/go/uuid/v1"
+	etcdMocks "github.com/douyu/jupiter-examples/uuid/gen/mocks/etcd"
+	mysqlMocks "github.com/douyu/jupiter-examples/uuid/gen/mocks/mysql"
+	redisMocks "github.com/douyu/jupiter-examples/uuid/gen/mocks/redis"
+	"github.com/douyu/jupiter-examples/uuid/internal/app/uuidserver/service"
+	"github.com/douyu/jupiter-examples/uuid/internal/pkg/mysql"
+	"github.com/douyu/jupiter/pkg/conf"
+	"github.com/douyu/jupiter/pkg/util/xerror"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+var _ = Describe("mysql segments", func() {
+	var (
+		db  *gorm.DB
+		cli *mysql.Mysql
+		ctx = context.Background()
+	)
+
+	BeforeEach(func() {
+		var err error
+		// writers queue up instead of failing, like they would on mysql
+		db, err = gorm.Open(sqlite.Open(filepath.Join(GinkgoT().TempDir(), "leaf.db")+"?_busy_timeout=5000&_txlock=immediate"),
+			&gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(db.AutoMigrate(&mysql.LeafAlloc{})).Should(Succeed())
+		Expect(db.Create(&[]mysql.LeafAlloc{
+			{BizTag: "orders", MaxId: 1, Step: 100},
+			{BizTag: "users", MaxId: 1, Step: 10},
+		}).Error).Should(Succeed())
+
+		cli = &mysql.Mysql{DB: db}
+	})
+
+	AfterEach(func() {
+		sqlDB, err := db.DB()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(sqlDB.Close()).Should(Succeed())
+	})
+
+	It("moves max_id a step ahead for every segment", func() {
+		segment, err := cli.FetchSegment(ctx, "orders")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(segment).Should(Equal(mysql.Segment{Start: 1, End: 101, Step: 100}))
+
+		segment, err = cli.FetchSegment(ctx, "orders")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(segment).Should(Equal(mysql.Segment{Start: 101, End: 201, Step: 100}))
+
+		var alloc mysql.LeafAlloc
+		Expect(db.Take(&alloc, "biz_tag = ?", "orders").Error).Should(Succeed())
+		Expect(alloc.MaxId).Should(BeEquivalentTo(201))
+	})
+
+	It("hands out disjoint segments to concurrent callers", func() {
+		var (
+			wg     sync.WaitGroup
+			mu     sync.Mutex
+			starts = map[int64]bool{}
+		)
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+
+				segment, err := cli.FetchSegment(ctx, "users")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(segment.End - segment.Start).Should(BeEquivalentTo(10))
+
+				mu.Lock()
+				defer mu.Unlock()
+				Expect(starts).ShouldNot(HaveKey(segment.Start))
+				starts[segment.Start] = true
+			}()
+		}
+		wg.Wait()
+
+		for start := int64(1); start < 200; start += 10 {
+			Expect(starts).Should(HaveKey(start))
+		}
+	})
+
+	It("reports a biz tag without row", func() {
+		_, err := cli.FetchSegment(ctx, "unknown")
+		Expect(err).Should(MatchError(mysql.ErrUnknownBizTag))
+	})
+
+	Context("uuid service", func() {
+		BeforeEach(func() {
+			conf.Set("jupiter.server.uuid.enableSegment", true)
+		})
+
+		AfterEach(func() {
+			conf.Set("jupiter.server.uuid.enableSegment", false)
+		})
+
+		It("hands out dense increasing ids across segments", func() {
+			uuidService, err := CreateUuidServiceWithMysql(&redisMocks.RedisInterface{}, &etcdMocks.EtcdInterface{}, cli)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for i := int64(1); i <= 1000; i++ {
+				res, err := uuidService.GetSegmentId(ctx, &uuidv1.GetSegmentIdRequest{BizTag: "users"})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(res.Data.Id).Should(Equal(i))
+			}
+
+			// the next segment is fetched ahead of time, but not further
+			Eventually(func() int64 {
+				var alloc mysql.LeafAlloc
+				Expect(db.Take(&alloc, "biz_tag = ?", "users").Error).Should(Succeed())
+				return alloc.MaxId
+			}).Should(BeEquivalentTo(1011))
+		})
+
+		It("fetches the next segment before the current one is used up", func() {
+			uuidService, err := CreateUuidServiceWithMysql(&redisMocks.RedisInterface{}, &etcdMocks.EtcdInterface{}, cli)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for i := 0; i < 9; i++ {
+				_, err := uuidService.GetSegmentId(ctx, &uuidv1.GetSegmentIdRequest{BizTag: "orders"})
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+
+			maxId := func() int64 {
+				var alloc mysql.LeafAlloc
+				Expect(db.Take(&alloc, "biz_tag = ?", "orders").Error).Should(Succeed())
+				return alloc.MaxId
+			}
+			Consistently(maxId, 100*time.Millisecond).Should(BeEquivalentTo(101))
+
+			// the 10th id uses up a tenth of the segment
+			_, err = uuidService.GetSegmentId(ctx, &uuidv1.GetSegmentIdRequest{BizTag: "orders"})
+			Expect(err).ShouldNot(HaveOccurred())
+			Eventually(maxId).Should(BeEquivalentTo(201))
+		})
+
+		It("keeps handing out the current segment while the database is slow", func() {
+			release := make(chan time.Time)
+			mockMysql := mysqlMocks.NewMysqlInterface(GinkgoT())
+			mockMysql.On("FetchSegment", mock.Anything, "orders").Return(mysql.Segment{Start: 1, End: 101, Step: 100}, nil).Once()
+			mockMysql.On("FetchSegment", mock.Anything, "orders").Return(mysql.Segment{Start: 501, End: 601, Step: 100}, nil).WaitUntil(release).Once()
+
+			uuidService, err := CreateUuidServiceWithMysql(&redisMocks.RedisInterface{}, &etcdMocks.EtcdInterface{}, mockMysql)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for i := int64(1); i <= 100; i++ {
+				res, err := uuidService.GetSegmentId(ctx, &uuidv1.GetSegmentIdRequest{BizTag: "orders"})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(res.Data.Id).Should(Equal(i))
+			}
+
+			// the current segment is used up, callers wait for the next one now
+			timeout, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+			defer cancel()
+			_, err = uuidService.GetSegmentId(timeout, &uuidv1.GetSegmentIdRequest{BizTag: "orders"})
+			Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrSegmentUnavailable.GetEcode()))
+
+			close(release)
+			res, err := uuidService.GetSegmentId(ctx, &uuidv1.GetSegmentIdRequest{BizTag: "orders"})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.Data.Id).Should(BeEquivalentTo(501))
+		})
+
+		It("fails once the segment is used up and the database is down", func() {
+			mockMysql := mysqlMocks.NewMysqlInterface(GinkgoT())
+			mockMysql.On("FetchSegment", mock.Anything, "orders").Return(mysql.Segment{Start: 1, End: 3, Step: 2}, nil).Once()
+			mockMysql.On("FetchSegment", mock.Anything, "orders").Return(mysql.Segment{}, errors.New("database is down"))
+
+			uuidService, err := CreateUuidServiceWithMysql(&redisMocks.RedisInterface{}, &etcdMocks.EtcdInterface{}, mockMysql)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for i := int64(1); i <= 2; i++ {
+				res, err := uuidService.GetSegmentId(ctx, &uuidv1.GetSegmentIdRequest{BizTag: "orders"})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(res.Data.Id).Should(Equal(i))
+			}
+
+			_, err = uuidService.GetSegmentId(ctx, &uuidv1.GetSegmentIdRequest{BizTag: "orders"})
+			Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrSegmentUnavailable.GetEcode()))
+			Expect(err).Should(MatchError(ContainSubstring("database is down")))
+		})
+
+		It("refuses unknown biz tags", func() {
+			uuidService, err := CreateUuidServiceWithMysql(&redisMocks.RedisInterface{}, &etcdMocks.EtcdInterface{}, cli)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for _, bizTag := range []string{"", "unknown"} {
+				_, err = uuidService.GetSegmentId(ctx, &uuidv1.GetSegmentIdRequest{BizTag: bizTag})
+				Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrUnknownBizTag.GetEcode()), bizTag)
+			}
+		})
+
+		It("is off unless enabled", func() {
+			conf.Set("jupiter.server.uuid.enableSegment", false)
+			uuidService, err := CreateUuidServiceWithMysql(&redisMocks.RedisInterface{}, &etcdMocks.EtcdInterface{}, cli)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = uuidService.GetSegmentId(ctx, &uuidv1.GetSegmentIdRequest{BizTag: "orders"})
+			Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrSegmentDisabled.GetEcode()))
+		})
+	})
+})
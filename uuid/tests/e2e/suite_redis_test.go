@@ -0,0 +1,609 @@
+package e2e
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	uuidv1 "github.com/douyu/jupiter-examples/uuid/gen/api/go/uuid/v1"
+	etcdMocks "github.com/douyu/jupiter-examples/uuid/gen/mocks/etcd"
+	redisMocks "github.com/douyu/jupiter-examples/uuid/gen/mocks/redis"
+	"github.com/douyu/jupiter-examples/uuid/internal/app/uuidserver/service"
+	"github.com/douyu/jupiter-examples/uuid/internal/pkg/redis"
+	xredis "github.com/douyu/jupiter/pkg/client/redis"
+	"github.com/douyu/jupiter/pkg/conf"
+	"github.com/douyu/jupiter/pkg/util/xerror"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+)
+
+var _ = Describe("redis node id lease", func() {
+	var (
+		server *miniredis.Miniredis
+		cli    *redis.Redis
+		ctx    = context.Background()
+	)
+
+	BeforeEach(func() {
+		server = miniredis.NewMiniRedis()
+		Expect(server.Start()).Should(Succeed())
+
+		config := xredis.DefaultConfig()
+		config.Master.Addr = server.Addr()
+		client, err := config.Build()
+		Expect(err).ShouldNot(HaveOccurred())
+		cli = &redis.Redis{Client: client}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("hands out distinct node ids to concurrent callers", func() {
+		var (
+			wg      sync.WaitGroup
+			mu      sync.Mutex
+			nodeIds = map[int64]bool{}
+		)
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+
+				nodeId, err := cli.AcquireNodeId(ctx, 1023, "owner", time.Minute)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				mu.Lock()
+				defer mu.Unlock()
+				Expect(nodeIds).ShouldNot(HaveKey(nodeId))
+				nodeIds[nodeId] = true
+			}()
+		}
+		wg.Wait()
+
+		Expect(nodeIds).Should(HaveLen(20))
+	})
+
+	It("reports the pool exhausted past the node bits", func() {
+		_, err := cli.AcquireNodeId(ctx, 1, "a", time.Minute)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, err = cli.AcquireNodeId(ctx, 1, "b", time.Minute)
+		Expect(err).Should(MatchError(redis.ErrNodeIdExhausted))
+	})
+
+	It("reuses the lowest node id freed by a release or an expired lease", func() {
+		for i := int64(1); i <= 3; i++ {
+			nodeId, err := cli.AcquireNodeId(ctx, 3, "a", time.Second)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(nodeId).Should(Equal(i))
+		}
+
+		Expect(cli.ReleaseNodeId(ctx, 2, "a")).Should(Succeed())
+		nodeId, err := cli.AcquireNodeId(ctx, 3, "b", time.Minute)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(nodeId).Should(Equal(int64(2)))
+
+		server.FastForward(2 * time.Second)
+		nodeId, err = cli.AcquireNodeId(ctx, 3, "c", time.Minute)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(nodeId).Should(Equal(int64(1)))
+	})
+
+	It("only lets the owner renew and release", func() {
+		nodeId, err := cli.AcquireNodeId(ctx, 1023, "a", time.Second)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(cli.RenewNodeId(ctx, nodeId, "b", time.Minute)).Should(MatchError(redis.ErrNodeLeaseLost))
+		Expect(cli.RenewNodeId(ctx, nodeId, "a", time.Minute)).Should(Succeed())
+
+		Expect(cli.ReleaseNodeId(ctx, nodeId, "b")).Should(Succeed())
+		Expect(cli.RenewNodeId(ctx, nodeId, "a", time.Minute)).Should(Succeed())
+
+		Expect(cli.ReleaseNodeId(ctx, nodeId, "a")).Should(Succeed())
+		Expect(cli.RenewNodeId(ctx, nodeId, "a", time.Minute)).Should(MatchError(redis.ErrNodeLeaseLost))
+	})
+
+	It("loses the lease once the ttl passes", func() {
+		nodeId, err := cli.AcquireNodeId(ctx, 1023, "a", time.Second)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		server.FastForward(2 * time.Second)
+		Expect(cli.RenewNodeId(ctx, nodeId, "a", time.Minute)).Should(MatchError(redis.ErrNodeLeaseLost))
+	})
+
+	It("keeps the high-water mark without a ttl", func() {
+		mark, err := cli.GetHighWaterMark(ctx, 1)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(mark).Should(BeZero())
+
+		Expect(cli.SetHighWaterMark(ctx, 1, 1700000000000)).Should(Succeed())
+		server.FastForward(time.Hour)
+
+		mark, err = cli.GetHighWaterMark(ctx, 1)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(mark).Should(Equal(int64(1700000000000)))
+	})
+
+	It("lists the live leases with their owners", func() {
+		for _, owner := range []string{"a", "b", "c"} {
+			_, err := cli.AcquireNodeId(ctx, 1023, owner, time.Minute)
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+		Expect(cli.ReleaseNodeId(ctx, 2, "b")).Should(Succeed())
+		server.FastForward(10 * time.Second)
+
+		leases, err := cli.ListNodeLeases(ctx, 1023)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(leases).Should(Equal([]redis.NodeLease{
+			{NodeId: 1, Owner: "a", TTL: 50 * time.Second},
+			{NodeId: 3, Owner: "c", TTL: 50 * time.Second},
+		}))
+
+		// past the node bits
+		leases, err = cli.ListNodeLeases(ctx, 2)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(leases).Should(HaveLen(1))
+	})
+
+	Context("node id of the server", func() {
+		BeforeEach(func() {
+			conf.Set("jupiter.server.uuid.enableRedis", true)
+			conf.Set("jupiter.server.uuid.nodeLeaseTTL", "300ms")
+			conf.Set("jupiter.server.uuid.highWaterMarkStore", "redis")
+			conf.Set("jupiter.server.uuid.highWaterMarkInterval", "100ms")
+		})
+
+		AfterEach(func() {
+			conf.Set("jupiter.server.uuid.enableRedis", false)
+			conf.Set("jupiter.server.uuid.nodeLeaseTTL", "30s")
+			conf.Set("jupiter.server.uuid.highWaterMarkStore", "")
+			conf.Set("jupiter.server.uuid.highWaterMarkInterval", "1s")
+		})
+
+		It("leases a node id again once its key expired and generates after the ids of the lost one", func() {
+			uuidService, err := CreateUuidService(cli, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+			defer uuidService.Close()
+
+			before, err := uuidService.GetUuidBySnowflake(ctx, &uuidv1.GetUuidBySnowflakeRequest{})
+			Expect(err).ShouldNot(HaveOccurred())
+			leases, err := cli.ListNodeLeases(ctx, 1023)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(leases).Should(HaveLen(1))
+			lost := leases[0]
+
+			// redis was out of reach for longer than the ttl
+			server.FastForward(time.Second)
+			Eventually(func() string {
+				leases, err := cli.ListNodeLeases(ctx, 1023)
+				Expect(err).ShouldNot(HaveOccurred())
+				if len(leases) == 0 {
+					return ""
+				}
+				return leases[0].Owner
+			}, 3*time.Second).ShouldNot(Or(BeEmpty(), Equal(lost.Owner)))
+
+			var after *uuidv1.GetUuidBySnowflakeResponse
+			Eventually(func() error {
+				after, err = uuidService.GetUuidBySnowflake(ctx, &uuidv1.GetUuidBySnowflakeRequest{})
+				return err
+			}, 3*time.Second).Should(Succeed())
+			Expect(after.Data.Id).Should(BeNumerically(">", before.Data.Id))
+
+			// the lowest free node id, under a lease of its own
+			leases, err = cli.ListNodeLeases(ctx, 1023)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(leases).Should(HaveLen(1))
+			Expect(leases[0].NodeId).Should(Equal(lost.NodeId))
+			Expect(leases[0].Owner).ShouldNot(Equal(lost.Owner))
+			Expect(uuidService.NodeId()).Should(Equal(lost.NodeId))
+		})
+
+		It("leases another node id once its own was taken over", func() {
+			uuidService, err := CreateUuidService(cli, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+			defer uuidService.Close()
+			Expect(uuidService.NodeId()).Should(Equal(int64(1)))
+
+			server.FastForward(time.Second)
+			nodeId, err := cli.AcquireNodeId(ctx, 1023, "other", time.Minute)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(nodeId).Should(Equal(int64(1)))
+
+			Eventually(uuidService.NodeId, 3*time.Second).Should(Equal(int64(2)))
+			res, err := uuidService.GetUuidBySnowflake(ctx, &uuidv1.GetUuidBySnowflakeRequest{})
+			Expect(err).ShouldNot(HaveOccurred())
+			parsed, err := uuidService.ParseSnowflake(ctx, &uuidv1.ParseSnowflakeRequest{Uuid: res.Data.Uuid})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(parsed.Data.NodeId).Should(Equal(int64(2)))
+		})
+	})
+
+	Context("leases of clients", func() {
+		BeforeEach(func() {
+			conf.Set("jupiter.server.uuid.enableRedis", true)
+			conf.Set("jupiter.server.uuid.enableNodeLeases", true)
+		})
+
+		AfterEach(func() {
+			conf.Set("jupiter.server.uuid.enableRedis", false)
+			conf.Set("jupiter.server.uuid.enableNodeLeases", false)
+		})
+
+		It("leases the node ids no server holds along with the layout", func() {
+			uuidService, err := CreateUuidService(cli, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+			defer uuidService.Close()
+			Expect(uuidService.NodeId()).Should(Equal(int64(1)))
+
+			res, err := uuidService.LeaseNodeId(ctx, &uuidv1.LeaseNodeIdRequest{Owner: "orders"})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.Data.NodeId).Should(Equal(int64(2)))
+			Expect(res.Data.Lease).Should(HavePrefix("client/orders/"))
+			Expect(res.Data.Ttl).Should(Equal((30 * time.Second).Milliseconds()))
+			Expect(res.Data.Epoch).Should(Equal(int64(1288834974657)))
+			Expect(res.Data.NodeBits).Should(BeEquivalentTo(10))
+			Expect(res.Data.StepBits).Should(BeEquivalentTo(12))
+
+			// the node id is not leased again while its lease lasts
+			server.FastForward(20 * time.Second)
+			other, err := uuidService.LeaseNodeId(ctx, &uuidv1.LeaseNodeIdRequest{Owner: "users"})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(other.Data.NodeId).Should(Equal(int64(3)))
+
+			list, err := uuidService.ListNodeLeases(ctx, &uuidv1.ListNodeLeasesRequest{})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(list.Data.Leases).Should(HaveLen(3))
+			Expect(list.Data.Leases[0].NodeId).Should(Equal(int64(1)))
+			Expect(list.Data.Leases[0].Client).Should(BeFalse())
+			Expect(list.Data.Leases[0].Self).Should(BeTrue())
+			Expect(list.Data.Leases[1].Owner).Should(Equal("orders"))
+			Expect(list.Data.Leases[1].Client).Should(BeTrue())
+			Expect(list.Data.Leases[1].Ttl).Should(Equal((10 * time.Second).Milliseconds()))
+			Expect(list.Data.Leases[2].Owner).Should(Equal("users"))
+
+			// the lease outlives its ttl with the renewals
+			renewed, err := uuidService.RenewNodeLease(ctx, &uuidv1.RenewNodeLeaseRequest{NodeId: 2, Lease: res.Data.Lease})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(renewed.Data.Ttl).Should(Equal((30 * time.Second).Milliseconds()))
+			server.FastForward(20 * time.Second)
+			_, err = uuidService.RenewNodeLease(ctx, &uuidv1.RenewNodeLeaseRequest{NodeId: 2, Lease: res.Data.Lease})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			// the lease of users was not renewed
+			server.FastForward(15 * time.Second)
+			_, err = uuidService.RenewNodeLease(ctx, &uuidv1.RenewNodeLeaseRequest{NodeId: 3, Lease: other.Data.Lease})
+			Expect(err).Should(Equal(service.ErrNodeLeaseLost))
+			_, err = uuidService.RenewNodeLease(ctx, &uuidv1.RenewNodeLeaseRequest{NodeId: 2, Lease: res.Data.Lease})
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("only renews and releases a lease with its secret", func() {
+			uuidService, err := CreateUuidService(cli, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+			defer uuidService.Close()
+
+			res, err := uuidService.LeaseNodeId(ctx, &uuidv1.LeaseNodeIdRequest{Owner: "orders"})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			list, err := uuidService.ListNodeLeases(ctx, &uuidv1.ListNodeLeasesRequest{})
+			Expect(err).ShouldNot(HaveOccurred())
+			for _, lease := range []string{"", "client/orders", "client/orders/guess", list.Data.Leases[0].Owner} {
+				_, err = uuidService.RenewNodeLease(ctx, &uuidv1.RenewNodeLeaseRequest{NodeId: res.Data.NodeId, Lease: lease})
+				Expect(err).Should(Equal(service.ErrNodeLeaseLost), lease)
+				_, _ = uuidService.ReleaseNodeLease(ctx, &uuidv1.ReleaseNodeLeaseRequest{NodeId: res.Data.NodeId, Lease: lease})
+			}
+			_, err = uuidService.RenewNodeLease(ctx, &uuidv1.RenewNodeLeaseRequest{NodeId: res.Data.NodeId, Lease: res.Data.Lease})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			// the lease of the server can't be touched by a client either
+			server.FastForward(20 * time.Second)
+			_, err = uuidService.RenewNodeLease(ctx, &uuidv1.RenewNodeLeaseRequest{NodeId: 1, Lease: list.Data.Leases[0].Owner})
+			Expect(err).Should(Equal(service.ErrNodeLeaseLost))
+
+			_, err = uuidService.ReleaseNodeLease(ctx, &uuidv1.ReleaseNodeLeaseRequest{NodeId: res.Data.NodeId, Lease: res.Data.Lease})
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = uuidService.RenewNodeLease(ctx, &uuidv1.RenewNodeLeaseRequest{NodeId: res.Data.NodeId, Lease: res.Data.Lease})
+			Expect(err).Should(Equal(service.ErrNodeLeaseLost))
+
+			// released, so it is the lowest free node id again
+			again, err := uuidService.LeaseNodeId(ctx, &uuidv1.LeaseNodeIdRequest{Owner: "orders"})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(again.Data.NodeId).Should(Equal(res.Data.NodeId))
+			Expect(again.Data.Lease).ShouldNot(Equal(res.Data.Lease))
+		})
+
+		It("refuses bad requests", func() {
+			uuidService, err := CreateUuidService(cli, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+			defer uuidService.Close()
+
+			_, err = uuidService.LeaseNodeId(ctx, &uuidv1.LeaseNodeIdRequest{Owner: strings.Repeat("a", 129)})
+			Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrInvalidLeaseOwner.GetEcode()))
+
+			_, err = uuidService.LeaseNodeId(ctx, &uuidv1.LeaseNodeIdRequest{Generator: "missing"})
+			Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrUnknownGenerator.GetEcode()))
+		})
+
+		It("fails clearly while redis is down", func() {
+			uuidService, err := CreateUuidService(cli, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+			defer uuidService.Close()
+
+			server.Close()
+			_, err = uuidService.LeaseNodeId(ctx, &uuidv1.LeaseNodeIdRequest{Owner: "orders"})
+			Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrNodeLeaseUnavailable.GetEcode()))
+			_, err = uuidService.ListNodeLeases(ctx, &uuidv1.ListNodeLeasesRequest{})
+			Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrNodeLeaseUnavailable.GetEcode()))
+		})
+
+		It("is off unless enabled", func() {
+			conf.Set("jupiter.server.uuid.enableNodeLeases", false)
+			uuidService, err := CreateUuidService(cli, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+			defer uuidService.Close()
+
+			_, err = uuidService.LeaseNodeId(ctx, &uuidv1.LeaseNodeIdRequest{Owner: "orders"})
+			Expect(err).Should(Equal(service.ErrNodeLeasesDisabled))
+			_, err = uuidService.ListNodeLeases(ctx, &uuidv1.ListNodeLeasesRequest{})
+			Expect(err).Should(Equal(service.ErrNodeLeasesDisabled))
+		})
+
+		It("needs the high-water marks next to the node id pool", func() {
+			defer conf.Set("jupiter.server.uuid.highWaterMarkStore", "")
+			for _, store := range []string{"file", "etcd"} {
+				conf.Set("jupiter.server.uuid.highWaterMarkStore", store)
+				_, err := CreateUuidService(cli, &etcdMocks.EtcdInterface{})
+				Expect(err).Should(MatchError(ContainSubstring("need the high-water marks in redis")), store)
+			}
+		})
+
+		It("needs the node id pool of redis or etcd", func() {
+			conf.Set("jupiter.server.uuid.enableRedis", false)
+			_, err := CreateUuidService(cli, &etcdMocks.EtcdInterface{})
+			Expect(err).Should(MatchError(ContainSubstring("EnableNodeLeases")))
+		})
+	})
+})
+
+var _ = Describe("redis sequences", func() {
+	var (
+		server *miniredis.Miniredis
+		cli    *redis.Redis
+		ctx    = context.Background()
+	)
+
+	BeforeEach(func() {
+		server = miniredis.NewMiniRedis()
+		Expect(server.Start()).Should(Succeed())
+
+		config := xredis.DefaultConfig()
+		config.Master.Addr = server.Addr()
+		client, err := config.Build()
+		Expect(err).ShouldNot(HaveOccurred())
+		cli = &redis.Redis{Client: client}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("reserves consecutive ranges from start on", func() {
+		last, err := cli.ReserveSequence(ctx, "invoices", 10, 1000, 0)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(last).Should(Equal(int64(1009)))
+
+		last, err = cli.ReserveSequence(ctx, "invoices", 10, 1000, 0)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(last).Should(Equal(int64(1019)))
+
+		Expect(server.TTL("jupiter.uuid.seq.invoices")).Should(BeZero())
+	})
+
+	It("expires a sequence ttl after its last reservation", func() {
+		last, err := cli.ReserveSequence(ctx, "tickets.2024-05-01", 1, 1, 48*time.Hour)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(last).Should(Equal(int64(1)))
+		Expect(server.TTL("jupiter.uuid.seq.tickets.2024-05-01")).Should(Equal(48 * time.Hour))
+
+		server.FastForward(49 * time.Hour)
+		Expect(server.Exists("jupiter.uuid.seq.tickets.2024-05-01")).Should(BeFalse())
+	})
+
+	Context("uuid service", func() {
+		BeforeEach(func() {
+			conf.Set("jupiter.server.uuid.sequences.invoices.start", 1000)
+			conf.Set("jupiter.server.uuid.sequences.invoices.cacheSize", 10)
+			conf.Set("jupiter.server.uuid.sequences.tickets.reset", "daily")
+		})
+
+		getSequence := func(uuidService *service.Uuid, key string) (int64, string, error) {
+			res, err := uuidService.GetSequence(ctx, &uuidv1.GetSequenceRequest{Key: key})
+			if err != nil {
+				return 0, "", err
+			}
+			Expect(res.Data.Uuid).Should(Equal(strconv.FormatInt(res.Data.Id, 10)))
+			return res.Data.Id, res.Data.Period, nil
+		}
+
+		It("hands out increasing numbers out of the ranges each instance reserved", func() {
+			first, err := CreateUuidService(cli, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+			second, err := CreateUuidService(cli, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for i := int64(1000); i < 1003; i++ {
+				number, period, err := getSequence(first, "invoices")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(number).Should(Equal(i))
+				Expect(period).Should(BeEmpty())
+			}
+
+			number, _, err := getSequence(second, "invoices")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(number).Should(Equal(int64(1010)))
+			Expect(server.Get("jupiter.uuid.seq.invoices")).Should(Equal("1019"))
+
+			number, _, err = getSequence(first, "invoices")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(number).Should(Equal(int64(1003)))
+		})
+
+		It("hands out the numbers of a reserved range while redis is down", func() {
+			uuidService, err := CreateUuidService(cli, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, _, err = getSequence(uuidService, "invoices")
+			Expect(err).ShouldNot(HaveOccurred())
+			server.Close()
+
+			for i := int64(1001); i < 1010; i++ {
+				number, _, err := getSequence(uuidService, "invoices")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(number).Should(Equal(i))
+			}
+
+			_, _, err = getSequence(uuidService, "invoices")
+			Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrSequenceUnavailable.GetEcode()))
+		})
+
+		It("starts a sequence over with every period", func() {
+			uuidService, err := CreateUuidService(cli, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			yesterday := time.Now().AddDate(0, 0, -1).Format("2006-01-02")
+			Expect(server.Set("jupiter.uuid.seq.tickets."+yesterday, "500")).Should(Succeed())
+
+			before := time.Now().Format("2006-01-02")
+			number, period, err := getSequence(uuidService, "tickets")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(number).Should(Equal(int64(1)))
+			Expect(period).Should(BeElementOf(before, time.Now().Format("2006-01-02")))
+
+			Expect(server.Get("jupiter.uuid.seq.tickets." + period)).Should(Equal("1"))
+			Expect(server.TTL("jupiter.uuid.seq.tickets." + period)).Should(Equal(48 * time.Hour))
+			Expect(server.Get("jupiter.uuid.seq.tickets." + yesterday)).Should(Equal("500"))
+		})
+
+		It("refuses unknown sequences", func() {
+			uuidService, err := CreateUuidService(cli, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for _, key := range []string{"", "unknown"} {
+				_, _, err = getSequence(uuidService, key)
+				Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrUnknownSequence.GetEcode()), key)
+			}
+		})
+
+		It("refuses sequences that are not valid", func() {
+			conf.Set("jupiter.server.uuid.sequences.tickets.reset", "weekly")
+			_, err := CreateUuidService(cli, &etcdMocks.EtcdInterface{})
+			conf.Set("jupiter.server.uuid.sequences.tickets.reset", "daily")
+			Expect(err).Should(MatchError(ContainSubstring("weekly")))
+
+			conf.Set("jupiter.server.uuid.sequences.invoices.cacheSize", -1)
+			_, err = CreateUuidService(cli, &etcdMocks.EtcdInterface{})
+			conf.Set("jupiter.server.uuid.sequences.invoices.cacheSize", 10)
+			Expect(err).Should(MatchError(ContainSubstring("cacheSize")))
+		})
+	})
+})
+
+var _ = Describe("redis idempotency keys", func() {
+	var (
+		server *miniredis.Miniredis
+		cli    *redis.Redis
+		ctx    = context.Background()
+	)
+
+	BeforeEach(func() {
+		server = miniredis.NewMiniRedis()
+		Expect(server.Start()).Should(Succeed())
+
+		config := xredis.DefaultConfig()
+		config.Master.Addr = server.Addr()
+		client, err := config.Build()
+		Expect(err).ShouldNot(HaveOccurred())
+		cli = &redis.Redis{Client: client}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("keeps the record of the first claim until the ttl passes", func() {
+		record, err := cli.GetIdempotencyKey(ctx, "order-1")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(record).Should(BeNil())
+
+		record, err = cli.ClaimIdempotencyKey(ctx, "order-1", []byte("first"), time.Minute)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(record)).Should(Equal("first"))
+
+		record, err = cli.ClaimIdempotencyKey(ctx, "order-1", []byte("second"), time.Minute)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(record)).Should(Equal("first"))
+
+		record, err = cli.GetIdempotencyKey(ctx, "order-1")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(record)).Should(Equal("first"))
+
+		server.FastForward(2 * time.Minute)
+		record, err = cli.ClaimIdempotencyKey(ctx, "order-1", []byte("second"), time.Minute)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(record)).Should(Equal("second"))
+	})
+
+	Context("uuid service", func() {
+		BeforeEach(func() {
+			conf.Set("jupiter.server.uuid.idempotencyStore", "redis")
+		})
+
+		AfterEach(func() {
+			conf.Set("jupiter.server.uuid.idempotencyStore", "")
+		})
+
+		It("hands the same ids to retries that reach another instance", func() {
+			first, err := CreateUuidService(cli, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+			second, err := CreateUuidService(cli, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			res, err := first.GetUuidBySnowflakeBatch(ctx, &uuidv1.GetUuidBySnowflakeBatchRequest{IdempotencyKey: "import-1", Count: 10, Format: "base58"})
+			Expect(err).ShouldNot(HaveOccurred())
+			retry, err := second.GetUuidBySnowflakeBatch(ctx, &uuidv1.GetUuidBySnowflakeBatchRequest{IdempotencyKey: "import-1", Count: 10, Format: "base58"})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(retry.Data.Uuids).Should(Equal(res.Data.Uuids))
+			Expect(retry.Data.Ids).Should(Equal(res.Data.Ids))
+
+			Expect(server.TTL("jupiter.uuid.idem.import-1")).Should(Equal(time.Hour))
+		})
+
+		It("fails instead of handing out ids a retry could not get back", func() {
+			uuidService, err := CreateUuidService(cli, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			server.Close()
+			_, err = uuidService.GetUuidBySnowflake(ctx, &uuidv1.GetUuidBySnowflakeRequest{IdempotencyKey: "order-1"})
+			Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrIdempotencyUnavailable.GetEcode()))
+
+			// requests without key don't depend on redis
+			_, err = uuidService.GetUuidBySnowflake(ctx, &uuidv1.GetUuidBySnowflakeRequest{})
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("refuses a redis that evicts keys before their ttl", func() {
+			mockRedis := redisMocks.NewRedisInterface(GinkgoT())
+			mockRedis.On("MaxMemoryPolicy", mock.Anything).Return("volatile-lru", nil)
+
+			_, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			Expect(err).Should(MatchError(ContainSubstring(`maxmemory-policy is "volatile-lru"`)))
+		})
+	})
+})
@@ -0,0 +1,252 @@
+package e2e
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strconv"
+	"time"
+
+	etcdMocks "github.com/douyu/jupiter-examples/uuid/gen/mocks/etcd"
+	mocks "github.com/douyu/jupiter-examples/uuid/gen/mocks/redis"
+	"github.com/douyu/jupiter-examples/uuid/internal/app/uuidserver/controller"
+	"github.com/douyu/jupiter-examples/uuid/internal/app/uuidserver/service"
+	"github.com/douyu/jupiter-examples/uuid/internal/pkg/resp"
+	xsnowflake "github.com/douyu/jupiter-examples/uuid/pkg/snowflake"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("resp server", func() {
+	var (
+		uuidService *service.Uuid
+		respServer  *resp.Server
+		client      *redis.Client
+		ctx         = context.Background()
+	)
+
+	BeforeEach(func() {
+		var err error
+		uuidService, err = CreateUuidService(&mocks.RedisInterface{}, &etcdMocks.EtcdInterface{})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		config := resp.DefaultConfig()
+		config.Host = "127.0.0.1"
+		config.Port = 0
+		respServer, err = config.Build(controller.NewUuidRespController(uuidService))
+		Expect(err).ShouldNot(HaveOccurred())
+		go respServer.Serve()
+
+		client = redis.NewClient(&redis.Options{Addr: respServer.Addr().String()})
+	})
+
+	AfterEach(func() {
+		client.Close()
+		respServer.Stop()
+		uuidService.Close()
+	})
+
+	It("answers SNOWFLAKE with ids of the node", func() {
+		var last int64
+		for i := 0; i < 100; i++ {
+			id, err := client.Do(ctx, "SNOWFLAKE").Int64()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(id).Should(BeNumerically(">", last))
+			last = id
+
+			parsed, err := xsnowflake.DefaultLayout().Parse(id)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(parsed.NodeId).Should(Equal(uuidService.NodeId()))
+		}
+
+		// command names are case-insensitive
+		_, err := client.Do(ctx, "snowflake").Int64()
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("answers SNOWFLAKE.BATCH with an array of increasing ids", func() {
+		ids, err := client.Do(ctx, "SNOWFLAKE.BATCH", 5000).Int64Slice()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(ids).Should(HaveLen(5000))
+		for i := 1; i < len(ids); i++ {
+			Expect(ids[i]).Should(BeNumerically(">", ids[i-1]))
+		}
+
+		err = client.Do(ctx, "SNOWFLAKE.BATCH", "many").Err()
+		Expect(err).Should(MatchError("ERR count must be a positive integer (ecode 10005)"))
+
+		err = client.Do(ctx, "SNOWFLAKE.BATCH", 10001).Err()
+		Expect(err).Should(MatchError("ERR count must be between 1 and 10000 (ecode 10016)"))
+	})
+
+	It("answers UUIDV4 with a random uuid", func() {
+		first, err := client.Do(ctx, "UUIDV4").Text()
+		Expect(err).ShouldNot(HaveOccurred())
+		second, err := client.Do(ctx, "UUIDV4").Text()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(first).ShouldNot(Equal(second))
+
+		parsed, err := uuid.Parse(first)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(parsed.Version()).Should(Equal(uuid.Version(4)))
+	})
+
+	It("answers PARSE with the parts of the id as field value pairs", func() {
+		id, err := client.Do(ctx, "SNOWFLAKE").Int64()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		fields, err := client.Do(ctx, "PARSE", id).StringSlice()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(fields).Should(HaveLen(12))
+
+		parts := map[string]string{}
+		for i := 0; i < len(fields); i += 2 {
+			parts[fields[i]] = fields[i+1]
+		}
+		Expect(parts["node_id"]).Should(Equal(strconv.FormatInt(uuidService.NodeId(), 10)))
+		timestamp, err := strconv.ParseInt(parts["timestamp"], 10, 64)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(time.UnixMilli(timestamp)).Should(BeTemporally("~", time.Now(), time.Minute))
+
+		err = client.Do(ctx, "PARSE", "not an id").Err()
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(HavePrefix("ERR "))
+		Expect(err.Error()).Should(HaveSuffix("(ecode 10018)"))
+	})
+
+	It("answers with the errors of the service and of the protocol", func() {
+		err := client.Do(ctx, "SNOWFLAKE", "missing").Err()
+		Expect(err).Should(MatchError(`ERR unknown generator "missing" (ecode 10012)`))
+
+		err = client.Do(ctx, "GET", "key").Err()
+		Expect(err).Should(MatchError("ERR unknown command 'GET'"))
+
+		err = client.Do(ctx, "UUIDV4", "extra").Err()
+		Expect(err).Should(MatchError("ERR wrong number of arguments for 'uuidv4' command"))
+
+		Expect(client.Ping(ctx).Val()).Should(Equal("PONG"))
+	})
+
+	It("answers a pipeline in order", func() {
+		pipe := client.Pipeline()
+		first := pipe.Do(ctx, "SNOWFLAKE")
+		batch := pipe.Do(ctx, "SNOWFLAKE.BATCH", 3)
+		unknown := pipe.Do(ctx, "NOPE")
+		last := pipe.Do(ctx, "SNOWFLAKE")
+		_, err := pipe.Exec(ctx)
+		Expect(err).Should(HaveOccurred())
+
+		firstId, err := first.Int64()
+		Expect(err).ShouldNot(HaveOccurred())
+		ids, err := batch.Int64Slice()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(ids).Should(HaveLen(3))
+		Expect(unknown.Err()).Should(MatchError("ERR unknown command 'NOPE'"))
+		lastId, err := last.Int64()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(ids[0]).Should(BeNumerically(">", firstId))
+		Expect(lastId).Should(BeNumerically(">", ids[2]))
+	})
+
+	It("answers inline commands as telnet sends them", func() {
+		conn, err := net.Dial("tcp", respServer.Addr().String())
+		Expect(err).ShouldNot(HaveOccurred())
+		defer conn.Close()
+		reader := bufio.NewReader(conn)
+
+		_, err = conn.Write([]byte("snowflake\r\n"))
+		Expect(err).ShouldNot(HaveOccurred())
+		line, err := reader.ReadString('\n')
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(line).Should(MatchRegexp(`^:\d+\r\n$`))
+
+		_, err = conn.Write([]byte("QUIT\r\n"))
+		Expect(err).ShouldNot(HaveOccurred())
+		line, err = reader.ReadString('\n')
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(line).Should(Equal("+OK\r\n"))
+
+		// the server closed the connection
+		_, err = reader.ReadString('\n')
+		Expect(err).Should(HaveOccurred())
+	})
+
+	It("closes the idle connections on a graceful stop", func() {
+		Expect(client.Ping(ctx).Err()).ShouldNot(HaveOccurred())
+
+		stopCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		Expect(respServer.GracefulStop(stopCtx)).Should(Succeed())
+
+		_, err := net.DialTimeout("tcp", respServer.Addr().String(), time.Second)
+		Expect(err).Should(HaveOccurred())
+	})
+
+	Context("with a command that blocks", func() {
+		var (
+			blocking *blockingHandler
+			server   *resp.Server
+			conn     net.Conn
+		)
+
+		BeforeEach(func() {
+			blocking = &blockingHandler{serving: make(chan struct{}), canceled: make(chan struct{})}
+
+			config := resp.DefaultConfig()
+			config.Host = "127.0.0.1"
+			config.Port = 0
+			var err error
+			server, err = config.Build(blocking)
+			Expect(err).ShouldNot(HaveOccurred())
+			go server.Serve()
+
+			conn, err = net.Dial("tcp", server.Addr().String())
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = conn.Write([]byte("BLOCK\r\n"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Eventually(blocking.serving).Should(BeClosed())
+		})
+
+		AfterEach(func() {
+			conn.Close()
+			server.Stop()
+		})
+
+		It("cancels the command once the client hangs up", func() {
+			Consistently(blocking.canceled, 100*time.Millisecond).ShouldNot(BeClosed())
+			conn.Close()
+			Eventually(blocking.canceled).Should(BeClosed())
+		})
+
+		It("cancels the command on stop", func() {
+			Expect(server.Stop()).Should(Succeed())
+			Eventually(blocking.canceled).Should(BeClosed())
+		})
+
+		It("answers the command the client sent before a half close", func() {
+			Expect(conn.(*net.TCPConn).CloseWrite()).Should(Succeed())
+			Eventually(blocking.canceled).Should(BeClosed())
+
+			line, err := bufio.NewReader(conn).ReadString('\n')
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(line).Should(Equal("-ERR canceled\r\n"))
+		})
+	})
+})
+
+// blockingHandler answers every command once its context is canceled
+type blockingHandler struct {
+	serving  chan struct{}
+	canceled chan struct{}
+}
+
+func (h *blockingHandler) Serve(ctx context.Context, w *resp.Writer, args []string) bool {
+	close(h.serving)
+	<-ctx.Done()
+	close(h.canceled)
+	w.WriteError("ERR canceled")
+	return true
+}
@@ -0,0 +1,154 @@
+package e2e
+
+import (
+	"sync/atomic"
+	"time"
+
+	xsnowflake "github.com/douyu/jupiter-examples/uuid/pkg/snowflake"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("snowflake generator", func() {
+	var (
+		// offset shifts the clock of the generator against the wall clock
+		offset    atomic.Int64
+		rollbacks []xsnowflake.ClockRollback
+	)
+
+	newGenerator := func(policy xsnowflake.RollbackPolicy, borrowLimit time.Duration) *xsnowflake.Generator {
+		generator, err := xsnowflake.GeneratorConfig{
+			Layout:         xsnowflake.DefaultLayout(),
+			NodeId:         3,
+			RollbackPolicy: policy,
+			BorrowLimit:    borrowLimit,
+			Now: func() time.Time {
+				return time.Now().Add(time.Duration(offset.Load()))
+			},
+			OnRollback: func(rollback xsnowflake.ClockRollback) {
+				rollbacks = append(rollbacks, rollback)
+			},
+		}.Build()
+		Expect(err).ShouldNot(HaveOccurred())
+		return generator
+	}
+
+	BeforeEach(func() {
+		offset.Store(0)
+		rollbacks = nil
+	})
+
+	It("mints strictly increasing ids across milliseconds", func() {
+		generator := newGenerator(xsnowflake.RollbackError, 0)
+
+		var last int64
+		for i := 0; i < 10000; i++ {
+			id, err := generator.Generate()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(id).Should(BeNumerically(">", last))
+			last = id
+		}
+
+		parsed, err := xsnowflake.DefaultLayout().Parse(last)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(parsed.NodeId).Should(Equal(int64(3)))
+		Expect(rollbacks).Should(BeEmpty())
+	})
+
+	It("refuses to mint with the error policy", func() {
+		generator := newGenerator(xsnowflake.RollbackError, 0)
+		_, err := generator.Generate()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		offset.Store(int64(-time.Minute))
+		_, err = generator.Generate()
+		Expect(err).Should(MatchError(xsnowflake.ErrClockRollback))
+		Expect(rollbacks).Should(HaveLen(1))
+		Expect(rollbacks[0].Behind).Should(BeNumerically("~", time.Minute, time.Second))
+		Expect(rollbacks[0].Err).Should(HaveOccurred())
+	})
+
+	It("waits for the clock with the block policy", func() {
+		generator := newGenerator(xsnowflake.RollbackBlock, 0)
+		before, err := generator.Generate()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		offset.Store(int64(-50 * time.Millisecond))
+		start := time.Now()
+		after, err := generator.Generate()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(after).Should(BeNumerically(">", before))
+		Expect(time.Since(start)).Should(BeNumerically(">=", 40*time.Millisecond))
+		Expect(rollbacks).ShouldNot(BeEmpty())
+		Expect(rollbacks[0].Err).ShouldNot(HaveOccurred())
+	})
+
+	It("borrows from the logical clock up to the limit", func() {
+		generator := newGenerator(xsnowflake.RollbackBorrow, 100*time.Millisecond)
+		before, err := generator.Generate()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		offset.Store(int64(-50 * time.Millisecond))
+		after, err := generator.Generate()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(after).Should(BeNumerically(">", before))
+		Expect(rollbacks).Should(HaveLen(1))
+
+		offset.Store(int64(-time.Second))
+		_, err = generator.Generate()
+		Expect(err).Should(MatchError(xsnowflake.ErrClockRollback))
+		Expect(rollbacks).Should(HaveLen(2))
+		Expect(rollbacks[1].Err).Should(HaveOccurred())
+	})
+
+	It("reports a rollback once instead of for every id minted during it", func() {
+		generator := newGenerator(xsnowflake.RollbackBorrow, 100*time.Millisecond)
+		_, err := generator.Generate()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		offset.Store(int64(-50 * time.Millisecond))
+		for i := 0; i < 10000; i++ {
+			_, err = generator.Generate()
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+		Expect(rollbacks).Should(HaveLen(1))
+
+		// the clock moves further back
+		offset.Store(int64(-80 * time.Millisecond))
+		_, err = generator.Generate()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(rollbacks).Should(HaveLen(2))
+		Expect(rollbacks[1].Behind).Should(BeNumerically(">", rollbacks[0].Behind))
+
+		// the clock catches up, the next rollback is a new one
+		offset.Store(0)
+		time.Sleep(150 * time.Millisecond)
+		_, err = generator.Generate()
+		Expect(err).ShouldNot(HaveOccurred())
+		offset.Store(int64(-50 * time.Millisecond))
+		_, err = generator.Generate()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(rollbacks).Should(HaveLen(3))
+	})
+
+	It("reports the ids refused during a rollback once", func() {
+		generator := newGenerator(xsnowflake.RollbackError, 0)
+		_, err := generator.Generate()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		offset.Store(int64(-time.Minute))
+		for i := 0; i < 100; i++ {
+			_, err = generator.Generate()
+			Expect(err).Should(MatchError(xsnowflake.ErrClockRollback))
+		}
+		Expect(rollbacks).Should(HaveLen(1))
+	})
+
+	It("refuses an unknown policy", func() {
+		_, err := xsnowflake.GeneratorConfig{
+			Layout:         xsnowflake.DefaultLayout(),
+			RollbackPolicy: "ignore",
+		}.Build()
+		Expect(err).Should(MatchError(ContainSubstring("unknown clock rollback policy")))
+	})
+})
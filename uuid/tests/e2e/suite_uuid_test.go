@@ -1,20 +1,1117 @@
 package e2e
 
 import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	uuidv1 "github.com/douyu/jupiter-examples/uuid/gen/api/go/uuid/v1"
+	etcdMocks "github.com/douyu/jupiter-examples/uuid/gen/mocks/etcd"
 	mocks "github.com/douyu/jupiter-examples/uuid/gen/mocks/redis"
+	"github.com/douyu/jupiter-examples/uuid/internal/app/uuidserver/controller"
+	"github.com/douyu/jupiter-examples/uuid/internal/app/uuidserver/service"
+	"github.com/douyu/jupiter-examples/uuid/internal/pkg/redis"
+	xsnowflake "github.com/douyu/jupiter-examples/uuid/pkg/snowflake"
+	"github.com/douyu/jupiter/pkg"
+	"github.com/douyu/jupiter/pkg/conf"
+	"github.com/douyu/jupiter/pkg/util/xerror"
+	"github.com/google/uuid"
+	"github.com/oklog/ulid/v2"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/segmentio/ksuid"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
 )
 
 var _ = Describe("uuidService", func() {
 
 	mockRedis := &mocks.RedisInterface{}
 
-	uuidService := CreateUuidService(mockRedis)
+	uuidService, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
 
 	Context("List", func() {
 		It("normal case", func() {
+			Expect(err).ShouldNot(HaveOccurred())
 			Expect(uuidService).ShouldNot(BeNil())
 		})
 	})
+
+	Context("snowflake batch", func() {
+		It("hands out unique increasing ids across milliseconds", func() {
+			// more than the 4096 steps of a single millisecond
+			res, err := uuidService.GetUuidBySnowflakeBatch(context.Background(), &uuidv1.GetUuidBySnowflakeBatchRequest{Count: 10000})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.Data.Uuids).Should(HaveLen(10000))
+
+			var last int64
+			for _, uuid := range res.Data.Uuids {
+				id, err := strconv.ParseInt(uuid, 10, 64)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(id).Should(BeNumerically(">", last))
+				last = id
+			}
+		})
+
+		It("refuses an empty or oversized batch", func() {
+			for _, count := range []uint32{0, 10001} {
+				_, err := uuidService.GetUuidBySnowflakeBatch(context.Background(), &uuidv1.GetUuidBySnowflakeBatchRequest{Count: count})
+				Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrInvalidBatchCount.GetEcode()))
+			}
+		})
+	})
+
+	Context("snowflake stream", func() {
+		// requests the client of the stream sends, recv fails with io.EOF once they are closed
+		requests := func(reqs chan *uuidv1.StreamUuidBySnowflakeRequest) func() (*uuidv1.StreamUuidBySnowflakeRequest, error) {
+			return func() (*uuidv1.StreamUuidBySnowflakeRequest, error) {
+				req, ok := <-reqs
+				if !ok {
+					return nil, io.EOF
+				}
+				return req, nil
+			}
+		}
+
+		It("generates a chunk only while the client has credit", func() {
+			reqs := make(chan *uuidv1.StreamUuidBySnowflakeRequest, 1)
+			reqs <- &uuidv1.StreamUuidBySnowflakeRequest{ChunkSize: 1000, Credit: 10}
+
+			var (
+				mu     sync.Mutex
+				seen   = map[string]bool{}
+				chunks = 0
+			)
+			chunksSent := func() int {
+				mu.Lock()
+				defer mu.Unlock()
+				return chunks
+			}
+
+			done := make(chan error, 1)
+			go func() {
+				defer GinkgoRecover()
+				done <- uuidService.StreamUuidBySnowflake(context.Background(), requests(reqs), func(res *uuidv1.StreamUuidBySnowflakeResponse) error {
+					mu.Lock()
+					defer mu.Unlock()
+					chunks++
+					for _, uuid := range res.Data.Uuids {
+						seen[uuid] = true
+					}
+					return nil
+				})
+			}()
+
+			Eventually(chunksSent, 5*time.Second).Should(Equal(10))
+			Consistently(chunksSent, 200*time.Millisecond).Should(Equal(10))
+
+			reqs <- &uuidv1.StreamUuidBySnowflakeRequest{Credit: 5}
+			Eventually(chunksSent, 5*time.Second).Should(Equal(15))
+			Consistently(chunksSent, 200*time.Millisecond).Should(Equal(15))
+
+			// the client closed its side of the stream
+			close(reqs)
+			Eventually(done).Should(Receive(BeNil()))
+			// every chunk had 1000 uuids, none of them seen before
+			Expect(seen).Should(HaveLen(15000))
+		})
+
+		It("stops once send fails", func() {
+			reqs := make(chan *uuidv1.StreamUuidBySnowflakeRequest, 1)
+			reqs <- &uuidv1.StreamUuidBySnowflakeRequest{Credit: 10}
+			sendErr := errors.New("consumer gone")
+			err := uuidService.StreamUuidBySnowflake(context.Background(), requests(reqs), func(res *uuidv1.StreamUuidBySnowflakeResponse) error {
+				Expect(res.Data.Uuids).Should(HaveLen(100))
+				return sendErr
+			})
+			Expect(err).Should(Equal(sendErr))
+		})
+
+		It("refuses an oversized chunk", func() {
+			reqs := make(chan *uuidv1.StreamUuidBySnowflakeRequest, 1)
+			reqs <- &uuidv1.StreamUuidBySnowflakeRequest{ChunkSize: 10001, Credit: 1}
+			err := uuidService.StreamUuidBySnowflake(context.Background(), requests(reqs), func(res *uuidv1.StreamUuidBySnowflakeResponse) error {
+				return nil
+			})
+			Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrInvalidBatchCount.GetEcode()))
+		})
+
+		It("streams over grpc as the client grants credit until it cancels", func() {
+			lis := bufconn.Listen(1 << 20)
+			server := grpc.NewServer()
+			uuidv1.RegisterUuidServiceServer(server, controller.NewUUuidGrpcController(uuidService))
+			go server.Serve(lis)
+			defer server.Stop()
+
+			conn, err := grpc.Dial("bufnet", grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return lis.DialContext(ctx)
+			}), grpc.WithTransportCredentials(insecure.NewCredentials()))
+			Expect(err).ShouldNot(HaveOccurred())
+			defer conn.Close()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			stream, err := uuidv1.NewUuidServiceClient(conn).StreamUuidBySnowflake(ctx)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(stream.Send(&uuidv1.StreamUuidBySnowflakeRequest{ChunkSize: 10, Credit: 5})).Should(Succeed())
+
+			for i := 0; i < 5; i++ {
+				res, err := stream.Recv()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(res.Data.Uuids).Should(HaveLen(10))
+			}
+
+			// without credit the server mints nothing, so the next chunk is generated after this id
+			time.Sleep(100 * time.Millisecond)
+			res, err := uuidService.GetUuidBySnowflake(context.Background(), &uuidv1.GetUuidBySnowflakeRequest{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(stream.Send(&uuidv1.StreamUuidBySnowflakeRequest{Credit: 1})).Should(Succeed())
+			chunk, err := stream.Recv()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(chunk.Data.Ids).Should(HaveLen(10))
+			Expect(chunk.Data.Ids[0]).Should(BeNumerically(">", res.Data.Id))
+
+			cancel()
+			for err == nil {
+				_, err = stream.Recv()
+			}
+			Expect(status.Code(err)).Should(Equal(codes.Canceled))
+		})
+
+		It("ends the grpc stream once the client closed its side and the credit is used up", func() {
+			lis := bufconn.Listen(1 << 20)
+			server := grpc.NewServer()
+			uuidv1.RegisterUuidServiceServer(server, controller.NewUUuidGrpcController(uuidService))
+			go server.Serve(lis)
+			defer server.Stop()
+
+			conn, err := grpc.Dial("bufnet", grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return lis.DialContext(ctx)
+			}), grpc.WithTransportCredentials(insecure.NewCredentials()))
+			Expect(err).ShouldNot(HaveOccurred())
+			defer conn.Close()
+
+			stream, err := uuidv1.NewUuidServiceClient(conn).StreamUuidBySnowflake(context.Background())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(stream.Send(&uuidv1.StreamUuidBySnowflakeRequest{ChunkSize: 10, Credit: 3})).Should(Succeed())
+			Expect(stream.CloseSend()).Should(Succeed())
+
+			for i := 0; i < 3; i++ {
+				_, err := stream.Recv()
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+			_, err = stream.Recv()
+			Expect(err).Should(Equal(io.EOF))
+		})
+	})
+
+	Context("parse snowflake", func() {
+		It("decodes the time, node id and step", func() {
+			res, err := uuidService.GetUuidBySnowflake(context.Background(), &uuidv1.GetUuidBySnowflakeRequest{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			parsed, err := uuidService.ParseSnowflake(context.Background(), &uuidv1.ParseSnowflakeRequest{Uuid: res.Data.Uuid})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(parsed.Data.NodeId).Should(Equal(uuidService.NodeId()))
+			Expect(time.UnixMilli(parsed.Data.Timestamp)).Should(BeTemporally("~", time.Now(), time.Second))
+
+			// the low 12 bits
+			id, _ := strconv.ParseInt(res.Data.Uuid, 10, 64)
+			Expect(parsed.Data.Step).Should(Equal(id & 0xfff))
+		})
+
+		It("rejects ids that don't fit the layout", func() {
+			for _, uuid := range []string{"", "abc", "-1", "9223372036854775807"} {
+				_, err := uuidService.ParseSnowflake(context.Background(), &uuidv1.ParseSnowflakeRequest{Uuid: uuid})
+				Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrInvalidSnowflake.GetEcode()), uuid)
+			}
+		})
+	})
+
+	Context("snowflake formats", func() {
+		It("encodes the uuid in the format and decodes it back", func() {
+			for _, format := range []string{"", "int64", "hex", "base32", "base36", "base58", "base62"} {
+				res, err := uuidService.GetUuidBySnowflake(context.Background(), &uuidv1.GetUuidBySnowflakeRequest{Format: format})
+				Expect(err).ShouldNot(HaveOccurred(), format)
+				Expect(res.Data.Uuid).Should(MatchRegexp(`^[0-9A-Za-z]+$`), format)
+
+				id, err := xsnowflake.Format(format).Decode(res.Data.Uuid)
+				Expect(err).ShouldNot(HaveOccurred(), format)
+				Expect(uint64(id)).Should(Equal(res.Data.Id), format)
+
+				parsed, err := uuidService.ParseSnowflake(context.Background(), &uuidv1.ParseSnowflakeRequest{Uuid: res.Data.Uuid, Format: format})
+				Expect(err).ShouldNot(HaveOccurred(), format)
+				Expect(parsed.Data.Id).Should(Equal(res.Data.Id), format)
+				Expect(parsed.Data.NodeId).Should(Equal(uuidService.NodeId()), format)
+			}
+		})
+
+		It("matches the known encodings", func() {
+			for format, encoded := range map[xsnowflake.Format]string{
+				xsnowflake.FormatInt64:  "1234567890123456789",
+				xsnowflake.FormatHex:    "112210f47de98115",
+				xsnowflake.FormatBase36: "9do1sj396nf9",
+				xsnowflake.FormatBase62: "1TCKi1nFuNh",
+			} {
+				Expect(format.Encode(1234567890123456789)).Should(Equal(encoded), string(format))
+			}
+
+			for _, format := range []xsnowflake.Format{xsnowflake.FormatBase32, xsnowflake.FormatBase58} {
+				for _, id := range []int64{0, 1, 57, 58, 1<<63 - 1} {
+					decoded, err := format.Decode(format.Encode(id))
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(decoded).Should(Equal(id), string(format))
+				}
+			}
+		})
+
+		It("returns the numeric ids of a batch", func() {
+			res, err := uuidService.GetUuidBySnowflakeBatch(context.Background(), &uuidv1.GetUuidBySnowflakeBatchRequest{Count: 100, Format: "base58"})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.Data.Ids).Should(HaveLen(100))
+
+			for i, uuid := range res.Data.Uuids {
+				Expect(xsnowflake.FormatBase58.Encode(int64(res.Data.Ids[i]))).Should(Equal(uuid))
+			}
+		})
+
+		It("refuses an unknown format and ids that are not in the format", func() {
+			_, err := uuidService.GetUuidBySnowflake(context.Background(), &uuidv1.GetUuidBySnowflakeRequest{Format: "base64"})
+			Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrUnknownFormat.GetEcode()))
+
+			for format, uuid := range map[string]string{
+				"hex":    "xyz",
+				"base58": "0OIl",
+				"base62": "zzzzzzzzzzzz",
+				"base32": "u",
+			} {
+				_, err := uuidService.ParseSnowflake(context.Background(), &uuidv1.ParseSnowflakeRequest{Uuid: uuid, Format: format})
+				Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrInvalidSnowflake.GetEcode()), format)
+			}
+		})
+	})
+
+	Context("snowflake range", func() {
+		It("bounds every id generated in the window", func() {
+			start := time.Now()
+			res, err := uuidService.GetUuidBySnowflakeBatch(context.Background(), &uuidv1.GetUuidBySnowflakeBatchRequest{Count: 5000})
+			Expect(err).ShouldNot(HaveOccurred())
+			end := time.Now()
+
+			window, err := uuidService.GetSnowflakeRange(context.Background(), &uuidv1.GetSnowflakeRangeRequest{
+				StartTime: start.UnixMilli(),
+				EndTime:   end.UnixMilli(),
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			min, _ := strconv.ParseInt(window.Data.MinUuid, 10, 64)
+			max, _ := strconv.ParseInt(window.Data.MaxUuid, 10, 64)
+			for _, uuid := range res.Data.Uuids {
+				id, _ := strconv.ParseInt(uuid, 10, 64)
+				Expect(id).Should(BeNumerically(">=", min))
+				Expect(id).Should(BeNumerically("<=", max))
+			}
+		})
+
+		It("matches the layout of the helper package", func() {
+			layout := xsnowflake.DefaultLayout()
+			at := time.UnixMilli(layout.Epoch + 1)
+
+			min, max, err := layout.Range(at, at)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(min).Should(Equal(int64(1 << 22)))
+			Expect(max).Should(Equal(int64(2<<22 - 1)))
+		})
+
+		It("rejects reversed windows and windows before the epoch", func() {
+			now := time.Now().UnixMilli()
+			for _, req := range []*uuidv1.GetSnowflakeRangeRequest{
+				{StartTime: now, EndTime: now - 1},
+				{StartTime: 0, EndTime: now},
+			} {
+				_, err := uuidService.GetSnowflakeRange(context.Background(), req)
+				Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrInvalidTimeWindow.GetEcode()))
+			}
+		})
+	})
+
+	Context("high-water mark", func() {
+		var dir string
+
+		BeforeEach(func() {
+			dir = GinkgoT().TempDir()
+			conf.Set("jupiter.server.uuid.highWaterMarkStore", "file")
+			conf.Set("jupiter.server.uuid.highWaterMarkDir", dir)
+			conf.Set("jupiter.server.uuid.highWaterMarkInterval", "50ms")
+		})
+
+		AfterEach(func() {
+			conf.Set("jupiter.server.uuid.highWaterMarkStore", "")
+			conf.Set("jupiter.server.uuid.highWaterMarkDir", "data")
+			conf.Set("jupiter.server.uuid.highWaterMarkInterval", "1s")
+			conf.Set("jupiter.server.uuid.highWaterMarkPolicy", "wait")
+			conf.Set("jupiter.server.uuid.highWaterMarkMaxWait", "10s")
+		})
+
+		writeMark := func(mark time.Time) {
+			Expect(os.WriteFile(filepath.Join(dir, "node-1.hwm"), []byte(strconv.FormatInt(mark.UnixMilli(), 10)), 0o644)).Should(Succeed())
+		}
+
+		It("saves the time of the last id on close", func() {
+			uuidService, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			res, err := uuidService.GetUuidBySnowflake(context.Background(), &uuidv1.GetUuidBySnowflakeRequest{})
+			Expect(err).ShouldNot(HaveOccurred())
+			uuidService.Close()
+
+			parsed, err := uuidService.ParseSnowflake(context.Background(), &uuidv1.ParseSnowflakeRequest{Uuid: res.Data.Uuid})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			content, err := os.ReadFile(filepath.Join(dir, "node-1.hwm"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(content)).Should(Equal(strconv.FormatInt(parsed.Data.Timestamp, 10)))
+		})
+
+		It("waits until the clock is past the mark", func() {
+			mark := time.Now().Add(200 * time.Millisecond)
+			writeMark(mark)
+
+			uuidService, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+			defer uuidService.Close()
+
+			res, err := uuidService.GetUuidBySnowflake(context.Background(), &uuidv1.GetUuidBySnowflakeRequest{})
+			Expect(err).ShouldNot(HaveOccurred())
+			parsed, err := uuidService.ParseSnowflake(context.Background(), &uuidv1.ParseSnowflakeRequest{Uuid: res.Data.Uuid})
+			Expect(err).ShouldNot(HaveOccurred())
+			// one interval is added to the mark for the ids that may have been issued after it was saved
+			Expect(parsed.Data.Timestamp).Should(BeNumerically(">", mark.Add(50*time.Millisecond).UnixMilli()))
+		})
+
+		It("refuses to start while the clock is behind the mark", func() {
+			conf.Set("jupiter.server.uuid.highWaterMarkPolicy", "refuse")
+			writeMark(time.Now().Add(time.Minute))
+
+			_, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrClockBehindHighWaterMark.GetEcode()))
+		})
+
+		It("refuses to wait longer than the max wait", func() {
+			conf.Set("jupiter.server.uuid.highWaterMarkMaxWait", "100ms")
+			writeMark(time.Now().Add(time.Minute))
+
+			_, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrClockBehindHighWaterMark.GetEcode()))
+		})
+
+		It("is refused for the node ids of redis or etcd", func() {
+			defer conf.Set("jupiter.server.uuid.nodeAllocator", "")
+			for _, allocator := range []string{"redis", "etcd"} {
+				conf.Set("jupiter.server.uuid.nodeAllocator", allocator)
+				_, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+				Expect(err).Should(MatchError(ContainSubstring("move between hosts")), allocator)
+			}
+		})
+
+		It("keeps the mark in redis", func() {
+			conf.Set("jupiter.server.uuid.highWaterMarkStore", "redis")
+
+			mockRedis := mocks.NewRedisInterface(GinkgoT())
+			mockRedis.On("GetHighWaterMark", mock.Anything, int64(1)).Return(int64(0), nil).Once()
+			mockRedis.On("SetHighWaterMark", mock.Anything, int64(1), mock.AnythingOfType("int64")).Return(nil)
+
+			uuidService, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = uuidService.GetUuidBySnowflake(context.Background(), &uuidv1.GetUuidBySnowflakeRequest{})
+			Expect(err).ShouldNot(HaveOccurred())
+			uuidService.Close()
+		})
+	})
+
+	Context("named generators", func() {
+		BeforeEach(func() {
+			conf.Set("jupiter.server.uuid.generators", []string{"orders", "users"})
+			conf.Set("jupiter.server.uuid.orders.epoch", 1577836800000)
+			conf.Set("jupiter.server.uuid.users.nodeBits", 8)
+			conf.Set("jupiter.server.uuid.users.stepBits", 14)
+		})
+
+		AfterEach(func() {
+			conf.Set("jupiter.server.uuid.generators", []string{})
+		})
+
+		It("mints every generator with a layout of its own", func() {
+			uuidService, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for generator, layout := range map[string]xsnowflake.Layout{
+				"":       xsnowflake.DefaultLayout(),
+				"orders": {Epoch: 1577836800000, NodeBits: 10, StepBits: 12},
+				"users":  {Epoch: xsnowflake.DefaultEpoch, NodeBits: 8, StepBits: 14},
+			} {
+				res, err := uuidService.GetUuidBySnowflake(context.Background(), &uuidv1.GetUuidBySnowflakeRequest{Generator: generator})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				id, err := layout.ParseString(res.Data.Uuid)
+				Expect(err).ShouldNot(HaveOccurred(), generator)
+				Expect(id.NodeId).Should(Equal(uuidService.NodeId()), generator)
+				Expect(id.Time).Should(BeTemporally("~", time.Now(), time.Second), generator)
+
+				parsed, err := uuidService.ParseSnowflake(context.Background(), &uuidv1.ParseSnowflakeRequest{Uuid: res.Data.Uuid, Generator: generator})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(parsed.Data.Step).Should(Equal(id.Step), generator)
+			}
+		})
+
+		It("doesn't leak a layout into another instance", func() {
+			conf.Set("jupiter.server.uuid.epoch", 1577836800000)
+			_, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			conf.Set("jupiter.server.uuid.epoch", xsnowflake.DefaultEpoch)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			res, err := uuidService.GetUuidBySnowflake(context.Background(), &uuidv1.GetUuidBySnowflakeRequest{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			id, err := xsnowflake.DefaultLayout().ParseString(res.Data.Uuid)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(id.Time).Should(BeTemporally("~", time.Now(), time.Second))
+		})
+
+		It("refuses an unknown generator", func() {
+			_, err := uuidService.GetUuidBySnowflake(context.Background(), &uuidv1.GetUuidBySnowflakeRequest{Generator: "orders"})
+			Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrUnknownGenerator.GetEcode()))
+		})
+
+		It("refuses a layout that doesn't share the 22 bits", func() {
+			conf.Set("jupiter.server.uuid.users.stepBits", 12)
+
+			_, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			Expect(err).Should(MatchError(ContainSubstring(`"users" DatacenterBits:0 NodeBits:8 and StepBits:12`)))
+		})
+	})
+
+	Context("datacenter bits", func() {
+		BeforeEach(func() {
+			conf.Set("jupiter.server.uuid.datacenterBits", 5)
+			conf.Set("jupiter.server.uuid.nodeBits", 5)
+			conf.Set("jupiter.server.uuid.datacenters", map[string]interface{}{"wuhan/f3": 3, "wuhan": 2, "beijing": 7})
+			pkg.SetAppRegion("wuhan")
+			pkg.SetAppZone("f3")
+		})
+
+		AfterEach(func() {
+			conf.Set("jupiter.server.uuid.datacenterBits", 0)
+			conf.Set("jupiter.server.uuid.nodeBits", 10)
+			conf.Set("jupiter.server.uuid.datacenters", map[string]interface{}{})
+			pkg.SetAppRegion("")
+			pkg.SetAppZone("")
+		})
+
+		parse := func(uuidService *service.Uuid) *uuidv1.ParseSnowflakeResponse_Data {
+			res, err := uuidService.GetUuidBySnowflake(context.Background(), &uuidv1.GetUuidBySnowflakeRequest{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			parsed, err := uuidService.ParseSnowflake(context.Background(), &uuidv1.ParseSnowflakeRequest{Uuid: res.Data.Uuid})
+			Expect(err).ShouldNot(HaveOccurred())
+			return parsed.Data
+		}
+
+		It("mints the datacenter of the region and zone", func() {
+			uuidService, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			parsed := parse(uuidService)
+			Expect(parsed.DatacenterId).Should(Equal(int64(3)))
+			Expect(parsed.Datacenter).Should(Equal("wuhan/f3"))
+			Expect(parsed.NodeId).Should(Equal(uuidService.NodeId()))
+			Expect(time.UnixMilli(parsed.Timestamp)).Should(BeTemporally("~", time.Now(), time.Second))
+		})
+
+		It("falls back to the region", func() {
+			pkg.SetAppZone("f9")
+
+			uuidService, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			parsed := parse(uuidService)
+			Expect(parsed.DatacenterId).Should(Equal(int64(2)))
+			Expect(parsed.Datacenter).Should(Equal("wuhan"))
+		})
+
+		It("refuses a region without datacenter id", func() {
+			pkg.SetAppRegion("shanghai")
+
+			_, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			Expect(err).Should(MatchError(ContainSubstring(`no datacenter id for region:"shanghai"`)))
+		})
+
+		It("refuses a datacenter id beyond the datacenter bits", func() {
+			conf.Set("jupiter.server.uuid.datacenterBits", 1)
+			conf.Set("jupiter.server.uuid.nodeBits", 9)
+
+			_, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			Expect(err).Should(MatchError(ContainSubstring("DatacenterID:3 err,must be between 0 and 1")))
+		})
+	})
+
+	Context("time-ordered ids", func() {
+		It("mints increasing uuid v7s", func() {
+			var last string
+			for i := 0; i < 10000; i++ {
+				res, err := uuidService.GetUuidV7(context.Background(), &uuidv1.GetUuidV7Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(res.Data.Uuid > last).Should(BeTrue(), "%s after %s", res.Data.Uuid, last)
+				last = res.Data.Uuid
+			}
+
+			id, err := uuid.Parse(last)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(id.Version()).Should(Equal(uuid.Version(7)))
+			Expect(time.Unix(id.Time().UnixTime())).Should(BeTemporally("~", time.Now(), time.Second))
+		})
+
+		It("mints increasing ulids", func() {
+			var last string
+			for i := 0; i < 10000; i++ {
+				res, err := uuidService.GetUlid(context.Background(), &uuidv1.GetUlidRequest{})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(res.Data.Uuid > last).Should(BeTrue(), "%s after %s", res.Data.Uuid, last)
+				last = res.Data.Uuid
+			}
+
+			id, err := ulid.ParseStrict(last)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ulid.Time(id.Time())).Should(BeTemporally("~", time.Now(), time.Second))
+		})
+
+		It("mints increasing ksuids", func() {
+			var last string
+			for i := 0; i < 10000; i++ {
+				res, err := uuidService.GetKsuid(context.Background(), &uuidv1.GetKsuidRequest{})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(res.Data.Uuid > last).Should(BeTrue(), "%s after %s", res.Data.Uuid, last)
+				last = res.Data.Uuid
+			}
+
+			id, err := ksuid.Parse(last)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(id.Time()).Should(BeTemporally("~", time.Now(), 2*time.Second))
+		})
+	})
+
+	Context("name-based uuids", func() {
+		const orders = "6f1d2c3e-8a4b-4c5d-9e6f-7a8b9c0d1e2f"
+
+		BeforeEach(func() {
+			conf.Set("jupiter.server.uuid.namespaces.orders", orders)
+		})
+
+		It("maps the same name to the same uuid", func() {
+			uuidService, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for _, version := range []uint32{3, 5} {
+				first, err := uuidService.GetUuidByName(context.Background(), &uuidv1.GetUuidByNameRequest{Version: version, Namespace: "orders", Name: "order-1"})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				second, err := uuidService.GetUuidByName(context.Background(), &uuidv1.GetUuidByNameRequest{Version: version, Namespace: orders, Name: "order-1"})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(second.Data.Uuid).Should(Equal(first.Data.Uuid))
+
+				id, err := uuid.Parse(first.Data.Uuid)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(id.Version()).Should(Equal(uuid.Version(version)))
+			}
+		})
+
+		It("mints the uuids of RFC 4122, v5 by default", func() {
+			res, err := uuidService.GetUuidByName(context.Background(), &uuidv1.GetUuidByNameRequest{Namespace: "dns", Name: "www.example.com"})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.Data.Uuid).Should(Equal("2ed6657d-e927-568b-95e1-2665a8aea6a2"))
+
+			res, err = uuidService.GetUuidByName(context.Background(), &uuidv1.GetUuidByNameRequest{Version: 3, Namespace: "dns", Name: "www.example.com"})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.Data.Uuid).Should(Equal("5df41881-3aed-3515-88a7-2f4a814cf09e"))
+		})
+
+		It("maps a batch in the order of the names", func() {
+			names := []string{"a", "b", "c", "a"}
+			res, err := uuidService.GetUuidByNameBatch(context.Background(), &uuidv1.GetUuidByNameBatchRequest{Namespace: "url", Names: names})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.Data.Uuids).Should(HaveLen(len(names)))
+
+			for i, name := range names {
+				Expect(res.Data.Uuids[i]).Should(Equal(uuid.NewSHA1(uuid.NameSpaceURL, []byte(name)).String()))
+			}
+		})
+
+		It("refuses an unknown namespace, version or batch size", func() {
+			_, err := uuidService.GetUuidByName(context.Background(), &uuidv1.GetUuidByNameRequest{Namespace: "unknown", Name: "a"})
+			Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrUnknownNamespace.GetEcode()))
+
+			_, err = uuidService.GetUuidByName(context.Background(), &uuidv1.GetUuidByNameRequest{Version: 4, Namespace: "dns", Name: "a"})
+			Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrInvalidVersion.GetEcode()))
+
+			_, err = uuidService.GetUuidByNameBatch(context.Background(), &uuidv1.GetUuidByNameBatchRequest{Namespace: "dns"})
+			Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrInvalidBatchCount.GetEcode()))
+
+			_, err = uuidService.GetUuidByNameBatch(context.Background(), &uuidv1.GetUuidByNameBatchRequest{Namespace: "dns", Names: make([]string, 10001)})
+			Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrInvalidBatchCount.GetEcode()))
+		})
+
+		It("refuses a namespace that is not a uuid", func() {
+			conf.Set("jupiter.server.uuid.namespaces.orders", "orders")
+			_, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			conf.Set("jupiter.server.uuid.namespaces.orders", orders)
+			Expect(err).Should(MatchError(ContainSubstring(`alias "orders" is not a uuid`)))
+		})
+	})
+
+	Context("public ids", func() {
+		BeforeEach(func() {
+			conf.Set("jupiter.server.uuid.prefixes.ord.kind", "snowflake")
+			conf.Set("jupiter.server.uuid.prefixes.usr.kind", "uuid_v7")
+		})
+
+		It("generates public ids of the registered prefixes and parses them back", func() {
+			uuidService, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for prefix, pattern := range map[string]string{
+				"ord": `^ord_[0-9A-Za-z]{12}$`,
+				"usr": `^usr_[0-9A-Za-z]{23}$`,
+			} {
+				res, err := uuidService.GetPublicId(context.Background(), &uuidv1.GetPublicIdRequest{Prefix: prefix})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(res.Data.Id).Should(MatchRegexp(pattern))
+
+				valid, err := uuidService.ValidatePublicId(context.Background(), &uuidv1.ValidatePublicIdRequest{Id: res.Data.Id})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(valid.Data.Valid).Should(BeTrue(), valid.Data.Detail)
+
+				parsed, err := uuidService.ParsePublicId(context.Background(), &uuidv1.ParsePublicIdRequest{Id: res.Data.Id})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(parsed.Data.Prefix).Should(Equal(prefix))
+				Expect(time.UnixMilli(parsed.Data.Timestamp)).Should(BeTemporally("~", time.Now(), time.Second))
+
+				if prefix == "ord" {
+					Expect(parsed.Data.Kind).Should(Equal(service.PublicIdSnowflake))
+					id, err := xsnowflake.DefaultLayout().ParseString(parsed.Data.Uuid)
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(id.NodeId).Should(Equal(uuidService.NodeId()))
+				} else {
+					Expect(parsed.Data.Kind).Should(Equal(service.PublicIdUuidV7))
+					id, err := uuid.Parse(parsed.Data.Uuid)
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(id.Version()).Should(Equal(uuid.Version(7)))
+				}
+			}
+		})
+
+		It("sorts the public ids of a prefix in the order they were generated", func() {
+			uuidService, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var last string
+			for i := 0; i < 5000; i++ {
+				res, err := uuidService.GetPublicId(context.Background(), &uuidv1.GetPublicIdRequest{Prefix: "ord"})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(res.Data.Id > last).Should(BeTrue(), "%s after %s", res.Data.Id, last)
+				last = res.Data.Id
+			}
+		})
+
+		It("rejects unknown prefixes, mistyped ids and malformed ids with their own ecodes", func() {
+			uuidService, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			res, err := uuidService.GetPublicId(context.Background(), &uuidv1.GetPublicIdRequest{Prefix: "ord"})
+			Expect(err).ShouldNot(HaveOccurred())
+			id := res.Data.Id
+
+			_, err = uuidService.GetPublicId(context.Background(), &uuidv1.GetPublicIdRequest{Prefix: "inv"})
+			Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrUnknownPrefix.GetEcode()))
+
+			_, err = uuidService.ParsePublicId(context.Background(), &uuidv1.ParsePublicIdRequest{Id: "inv" + id[3:]})
+			Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrUnknownPrefix.GetEcode()))
+
+			// every single mistyped character is caught by the check character
+			for i := len("ord_"); i < len(id); i++ {
+				typo := []byte(id)
+				typo[i] = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"[(strings.IndexByte("0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz", typo[i])+7)%62]
+
+				_, err = uuidService.ParsePublicId(context.Background(), &uuidv1.ParsePublicIdRequest{Id: string(typo)})
+				Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrBadChecksum.GetEcode()), string(typo))
+
+				valid, err := uuidService.ValidatePublicId(context.Background(), &uuidv1.ValidatePublicIdRequest{Id: string(typo)})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(valid.Data.Valid).Should(BeFalse())
+				Expect(valid.Data.Reason).Should(BeEquivalentTo(service.ErrBadChecksum.GetEcode()))
+			}
+
+			for _, malformed := range []string{"", "ord", "ord_", "ord_ab", "Ord" + id[3:], "ord_" + id[4:len(id)-1] + "-"} {
+				_, err = uuidService.ParsePublicId(context.Background(), &uuidv1.ParsePublicIdRequest{Id: malformed})
+				Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrInvalidPublicId.GetEcode()), malformed)
+			}
+
+			// a snowflake public id under the prefix of uuids v7
+			_, err = uuidService.ParsePublicId(context.Background(), &uuidv1.ParsePublicIdRequest{Id: "usr" + id[3:]})
+			Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrInvalidPublicId.GetEcode()))
+		})
+
+		It("refuses prefixes that are not valid or name an unknown generator", func() {
+			conf.Set("jupiter.server.uuid.prefixes.ord.generator", "missing")
+			_, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			conf.Set("jupiter.server.uuid.prefixes.ord.generator", "")
+			Expect(err).Should(MatchError(ContainSubstring(`generator "missing" of "ord" is not in Generators`)))
+
+			conf.Set("jupiter.server.uuid.prefixes.usr.kind", "ulid")
+			_, err = CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			conf.Set("jupiter.server.uuid.prefixes.usr.kind", "uuid_v7")
+			Expect(err).Should(MatchError(ContainSubstring(`kind "ulid" of "usr"`)))
+		})
+	})
+
+	Context("obfuscated ids", func() {
+		BeforeEach(func() {
+			conf.Set("jupiter.server.uuid.obfuscationKeys.1", "a first key of at least 16 bytes")
+			conf.Set("jupiter.server.uuid.obfuscationKeyVersion", 1)
+		})
+
+		It("obfuscates snowflake uuids and recovers them", func() {
+			uuidService, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			res, err := uuidService.GetUuidBySnowflakeBatch(context.Background(), &uuidv1.GetUuidBySnowflakeBatchRequest{Count: 1000})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var increasing int
+			var last int64
+			for _, id := range append(res.Data.Ids, 0, 1, 1<<63-1) {
+				encoded, err := uuidService.EncodeObfuscatedId(context.Background(), &uuidv1.EncodeObfuscatedIdRequest{Id: id})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(encoded.Data.ObfuscatedId).Should(MatchRegexp(`^1[0-9A-Za-z]+$`))
+				Expect(encoded.Data.KeyVersion).Should(BeEquivalentTo(1))
+
+				obfuscated, err := xsnowflake.FormatBase62.Decode(encoded.Data.ObfuscatedId[1:])
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(uint64(obfuscated)).ShouldNot(Equal(id))
+				if obfuscated > last {
+					increasing++
+				}
+				last = obfuscated
+
+				decoded, err := uuidService.DecodeObfuscatedId(context.Background(), &uuidv1.DecodeObfuscatedIdRequest{ObfuscatedId: encoded.Data.ObfuscatedId})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(decoded.Data.Id).Should(Equal(id))
+				Expect(decoded.Data.Uuid).Should(Equal(strconv.FormatUint(id, 10)))
+				Expect(decoded.Data.KeyVersion).Should(BeEquivalentTo(1))
+			}
+
+			// the order of the uuids is gone, about half of the neighbours are in order by chance
+			Expect(increasing).Should(BeNumerically("~", 500, 100))
+		})
+
+		It("encodes with the current key version and still decodes the retired ones", func() {
+			uuidService, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			old, err := uuidService.EncodeObfuscatedId(context.Background(), &uuidv1.EncodeObfuscatedIdRequest{Id: 1851374624411025408})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			conf.Set("jupiter.server.uuid.obfuscationKeys.2", "the second key, of at least 16 bytes too")
+			conf.Set("jupiter.server.uuid.obfuscationKeyVersion", 2)
+			rotated, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			conf.Set("jupiter.server.uuid.obfuscationKeyVersion", 1)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			encoded, err := rotated.EncodeObfuscatedId(context.Background(), &uuidv1.EncodeObfuscatedIdRequest{Id: 1851374624411025408})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(encoded.Data.KeyVersion).Should(BeEquivalentTo(2))
+			Expect(encoded.Data.ObfuscatedId).Should(HavePrefix("2"))
+			Expect(encoded.Data.ObfuscatedId[1:]).ShouldNot(Equal(old.Data.ObfuscatedId[1:]))
+
+			for _, obfuscated := range []string{old.Data.ObfuscatedId, encoded.Data.ObfuscatedId} {
+				decoded, err := rotated.DecodeObfuscatedId(context.Background(), &uuidv1.DecodeObfuscatedIdRequest{ObfuscatedId: obfuscated})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(decoded.Data.Id).Should(BeEquivalentTo(1851374624411025408))
+			}
+
+			_, err = rotated.DecodeObfuscatedId(context.Background(), &uuidv1.DecodeObfuscatedIdRequest{ObfuscatedId: "9" + encoded.Data.ObfuscatedId[1:]})
+			Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrUnknownKeyVersion.GetEcode()))
+		})
+
+		It("refuses ids that are not snowflake uuids or not obfuscated ids", func() {
+			uuidService, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = uuidService.EncodeObfuscatedId(context.Background(), &uuidv1.EncodeObfuscatedIdRequest{Id: 1 << 63})
+			Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrInvalidSnowflake.GetEcode()))
+
+			for _, obfuscated := range []string{"", "1", "1-", "-1", "1zzzzzzzzzzzz"} {
+				_, err = uuidService.DecodeObfuscatedId(context.Background(), &uuidv1.DecodeObfuscatedIdRequest{ObfuscatedId: obfuscated})
+				Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrInvalidObfuscatedId.GetEcode()), obfuscated)
+			}
+		})
+
+		It("is off without keys", func() {
+			// created before any key was configured
+			_, err := uuidService.EncodeObfuscatedId(context.Background(), &uuidv1.EncodeObfuscatedIdRequest{Id: 1})
+			Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrObfuscationDisabled.GetEcode()))
+		})
+
+		It("refuses short keys and a key version without key", func() {
+			conf.Set("jupiter.server.uuid.obfuscationKeys.1", "too short")
+			_, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			conf.Set("jupiter.server.uuid.obfuscationKeys.1", "a first key of at least 16 bytes")
+			Expect(err).Should(MatchError(ContainSubstring("key version 1")))
+
+			conf.Set("jupiter.server.uuid.obfuscationKeyVersion", 3)
+			_, err = CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			conf.Set("jupiter.server.uuid.obfuscationKeyVersion", 1)
+			Expect(err).Should(MatchError(ContainSubstring("ObfuscationKeyVersion:3")))
+		})
+	})
+
+	Context("idempotency keys", func() {
+		BeforeEach(func() {
+			conf.Set("jupiter.server.uuid.idempotencyStore", "memory")
+		})
+
+		AfterEach(func() {
+			conf.Set("jupiter.server.uuid.idempotencyStore", "")
+			conf.Set("jupiter.server.uuid.idempotencyTTL", "1h")
+			conf.Set("jupiter.server.uuid.idempotencyMaxKeys", 100000)
+		})
+
+		It("hands the same ids to retries with the same key", func() {
+			uuidService, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			first, err := uuidService.GetUuidBySnowflake(context.Background(), &uuidv1.GetUuidBySnowflakeRequest{IdempotencyKey: "order-1", Format: "base62"})
+			Expect(err).ShouldNot(HaveOccurred())
+			retry, err := uuidService.GetUuidBySnowflake(context.Background(), &uuidv1.GetUuidBySnowflakeRequest{IdempotencyKey: "order-1", Format: "base62"})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(retry.Data).Should(Equal(first.Data))
+
+			other, err := uuidService.GetUuidBySnowflake(context.Background(), &uuidv1.GetUuidBySnowflakeRequest{IdempotencyKey: "order-2", Format: "base62"})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(other.Data.Id).ShouldNot(Equal(first.Data.Id))
+
+			batch, err := uuidService.GetUuidBySnowflakeBatch(context.Background(), &uuidv1.GetUuidBySnowflakeBatchRequest{IdempotencyKey: "import-1", Count: 100})
+			Expect(err).ShouldNot(HaveOccurred())
+			retried, err := uuidService.GetUuidBySnowflakeBatch(context.Background(), &uuidv1.GetUuidBySnowflakeBatchRequest{IdempotencyKey: "import-1", Count: 100})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(retried.Data.Ids).Should(Equal(batch.Data.Ids))
+			Expect(retried.Data.Uuids).Should(Equal(batch.Data.Uuids))
+		})
+
+		It("hands one answer to concurrent retries", func() {
+			uuidService, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var (
+				wg  sync.WaitGroup
+				ids = make([]uint64, 20)
+			)
+			for i := range ids {
+				wg.Add(1)
+				go func(i int) {
+					defer GinkgoRecover()
+					defer wg.Done()
+
+					res, err := uuidService.GetUuidBySnowflake(context.Background(), &uuidv1.GetUuidBySnowflakeRequest{IdempotencyKey: "order-1"})
+					Expect(err).ShouldNot(HaveOccurred())
+					ids[i] = res.Data.Id
+				}(i)
+			}
+			wg.Wait()
+
+			for _, id := range ids {
+				Expect(id).Should(Equal(ids[0]))
+			}
+		})
+
+		It("refuses a key reused for another request", func() {
+			uuidService, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = uuidService.GetUuidBySnowflakeBatch(context.Background(), &uuidv1.GetUuidBySnowflakeBatchRequest{IdempotencyKey: "import-1", Count: 10})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for _, req := range []*uuidv1.GetUuidBySnowflakeBatchRequest{
+				{IdempotencyKey: "import-1", Count: 11},
+				{IdempotencyKey: "import-1", Count: 10, Format: "hex"},
+			} {
+				_, err = uuidService.GetUuidBySnowflakeBatch(context.Background(), req)
+				Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrIdempotencyKeyReused.GetEcode()), req.String())
+			}
+
+			_, err = uuidService.GetUuidBySnowflake(context.Background(), &uuidv1.GetUuidBySnowflakeRequest{IdempotencyKey: "import-1"})
+			Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrIdempotencyKeyReused.GetEcode()))
+
+			_, err = uuidService.GetUuidBySnowflake(context.Background(), &uuidv1.GetUuidBySnowflakeRequest{IdempotencyKey: strings.Repeat("k", 257)})
+			Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrInvalidIdempotencyKey.GetEcode()))
+		})
+
+		It("refuses new keys while full instead of evicting live ones", func() {
+			conf.Set("jupiter.server.uuid.idempotencyTTL", "300ms")
+			conf.Set("jupiter.server.uuid.idempotencyMaxKeys", 2)
+			uuidService, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			ids := map[string]uint64{}
+			for _, key := range []string{"a", "b"} {
+				res, err := uuidService.GetUuidBySnowflake(context.Background(), &uuidv1.GetUuidBySnowflakeRequest{IdempotencyKey: key})
+				Expect(err).ShouldNot(HaveOccurred())
+				ids[key] = res.Data.Id
+			}
+
+			_, err = uuidService.GetUuidBySnowflake(context.Background(), &uuidv1.GetUuidBySnowflakeRequest{IdempotencyKey: "c"})
+			Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrIdempotencyStoreFull.GetEcode()))
+
+			res, err := uuidService.GetUuidBySnowflake(context.Background(), &uuidv1.GetUuidBySnowflakeRequest{IdempotencyKey: "a"})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.Data.Id).Should(Equal(ids["a"]))
+
+			// the keys expire with their ttl and make room again
+			Eventually(func() error {
+				_, err := uuidService.GetUuidBySnowflake(context.Background(), &uuidv1.GetUuidBySnowflakeRequest{IdempotencyKey: "c"})
+				return err
+			}).Should(Succeed())
+		})
+
+		It("refuses keys without an idempotency store", func() {
+			// created before the store was configured
+			_, err := uuidService.GetUuidBySnowflake(context.Background(), &uuidv1.GetUuidBySnowflakeRequest{IdempotencyKey: "order-1"})
+			Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrIdempotencyDisabled.GetEcode()))
+
+			_, err = uuidService.GetUuidBySnowflakeBatch(context.Background(), &uuidv1.GetUuidBySnowflakeBatchRequest{IdempotencyKey: "import-1", Count: 1})
+			Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrIdempotencyDisabled.GetEcode()))
+		})
+	})
+
+	Context("redis node id", func() {
+		BeforeEach(func() {
+			conf.Set("jupiter.server.uuid.enableRedis", true)
+			conf.Set("jupiter.server.uuid.nodeLeaseTTL", "300ms")
+		})
+
+		AfterEach(func() {
+			conf.Set("jupiter.server.uuid.enableRedis", false)
+			conf.Set("jupiter.server.uuid.nodeLeaseTTL", "30s")
+		})
+
+		It("leases the node id, renews it and releases it on close", func() {
+			mockRedis := mocks.NewRedisInterface(GinkgoT())
+			mockRedis.On("AcquireNodeId", mock.Anything, int64(1023), mock.AnythingOfType("string"), 300*time.Millisecond).Return(int64(7), nil).Once()
+			mockRedis.On("RenewNodeId", mock.Anything, int64(7), mock.AnythingOfType("string"), 300*time.Millisecond).Return(nil)
+			mockRedis.On("ReleaseNodeId", mock.Anything, int64(7), mock.AnythingOfType("string")).Return(nil).Once()
+
+			uuidService, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			res, err := uuidService.GetUuidBySnowflake(context.Background(), &uuidv1.GetUuidBySnowflakeRequest{})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.Data.Uuid).ShouldNot(BeEmpty())
+
+			Eventually(func() int {
+				return len(mockRedis.Calls)
+			}, time.Second).Should(BeNumerically(">=", 2))
+
+			uuidService.Close()
+		})
+
+		It("stops generating once the lease is lost until it leased another node id", func() {
+			mockRedis := mocks.NewRedisInterface(GinkgoT())
+			mockRedis.On("AcquireNodeId", mock.Anything, int64(1023), mock.AnythingOfType("string"), 300*time.Millisecond).Return(int64(8), nil).Once()
+			mockRedis.On("RenewNodeId", mock.Anything, int64(8), mock.AnythingOfType("string"), 300*time.Millisecond).Return(redis.ErrNodeLeaseLost).Once()
+			mockRedis.On("ReleaseNodeId", mock.Anything, int64(8), mock.AnythingOfType("string")).Return(nil).Once()
+			// the pool is down for a while
+			mockRedis.On("AcquireNodeId", mock.Anything, int64(1023), mock.AnythingOfType("string"), 300*time.Millisecond).Return(int64(0), errors.New("redis down")).Twice()
+			mockRedis.On("AcquireNodeId", mock.Anything, int64(1023), mock.AnythingOfType("string"), 300*time.Millisecond).Return(int64(9), nil).Once()
+			mockRedis.On("RenewNodeId", mock.Anything, int64(9), mock.AnythingOfType("string"), 300*time.Millisecond).Return(nil).Maybe()
+			mockRedis.On("ReleaseNodeId", mock.Anything, int64(9), mock.AnythingOfType("string")).Return(nil).Once()
+
+			uuidService, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+			defer uuidService.Close()
+
+			Eventually(func() error {
+				_, err := uuidService.GetUuidBySnowflake(context.Background(), &uuidv1.GetUuidBySnowflakeRequest{})
+				return err
+			}, time.Second).Should(Equal(service.ErrNodeLeaseExpired))
+
+			Eventually(uuidService.NodeId, time.Second).Should(Equal(int64(9)))
+			res, err := uuidService.GetUuidBySnowflake(context.Background(), &uuidv1.GetUuidBySnowflakeRequest{})
+			Expect(err).ShouldNot(HaveOccurred())
+			parsed, err := xsnowflake.DefaultLayout().Parse(int64(res.Data.Id))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(parsed.NodeId).Should(Equal(int64(9)))
+		})
+
+		It("reports an exhausted node id pool", func() {
+			mockRedis := mocks.NewRedisInterface(GinkgoT())
+			mockRedis.On("AcquireNodeId", mock.Anything, int64(1023), mock.AnythingOfType("string"), 300*time.Millisecond).Return(int64(0), redis.ErrNodeIdExhausted).Once()
+
+			_, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			Expect(xerror.Convert(err).GetEcode()).Should(Equal(service.ErrNodeIdExhausted.GetEcode()))
+			Expect(err.Error()).Should(ContainSubstring("all 1023 node ids are leased"))
+		})
+	})
+
+	Context("node id allocator", func() {
+		AfterEach(func() {
+			conf.Set("jupiter.server.uuid.nodeAllocator", "")
+			conf.Set("jupiter.server.uuid.hostname", "")
+			conf.Set("jupiter.server.uuid.nodeIp", "")
+		})
+
+		It("takes the StatefulSet ordinal of the hostname", func() {
+			conf.Set("jupiter.server.uuid.nodeAllocator", "hostname")
+			conf.Set("jupiter.server.uuid.hostname", "uuidserver-12")
+
+			uuidService, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(uuidService.NodeId()).Should(Equal(int64(13)))
+		})
+
+		It("refuses a hostname without ordinal", func() {
+			conf.Set("jupiter.server.uuid.nodeAllocator", "hostname")
+			conf.Set("jupiter.server.uuid.hostname", "uuidserver")
+
+			_, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			Expect(err).Should(MatchError(ContainSubstring("has no StatefulSet ordinal")))
+		})
+
+		It("takes the low bits of the pod ip", func() {
+			conf.Set("jupiter.server.uuid.nodeAllocator", "ip")
+			conf.Set("jupiter.server.uuid.nodeIp", "10.1.6.9")
+
+			uuidService, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			Expect(err).ShouldNot(HaveOccurred())
+			// the low 10 bits of .6.9
+			Expect(uuidService.NodeId()).Should(Equal(int64(6&3<<8 | 9)))
+		})
+
+		It("refuses an unknown allocator", func() {
+			conf.Set("jupiter.server.uuid.nodeAllocator", "zookeeper")
+
+			_, err := CreateUuidService(mockRedis, &etcdMocks.EtcdInterface{})
+			Expect(err).Should(MatchError(ContainSubstring("unknown node id allocator")))
+		})
+	})
 })
@@ -5,13 +5,22 @@ package e2e
 
 import (
 	"github.com/douyu/jupiter-examples/uuid/internal/app/uuidserver/service"
+	"github.com/douyu/jupiter-examples/uuid/internal/pkg/etcd"
+	"github.com/douyu/jupiter-examples/uuid/internal/pkg/mysql"
 	"github.com/douyu/jupiter-examples/uuid/internal/pkg/redis"
 	"github.com/google/wire"
 )
 
-func CreateUuidService(redisCli redis.RedisInterface) *service.Uuid {
+func CreateUuidService(redisCli redis.RedisInterface, etcdCli etcd.EtcdInterface) (*service.Uuid, error) {
 	panic(wire.Build(
 		service.NewUuidService,
-		wire.Struct(new(service.Options), "Redis"),
+		wire.Struct(new(service.Options), "Redis", "Etcd"),
+	))
+}
+
+func CreateUuidServiceWithMysql(redisCli redis.RedisInterface, etcdCli etcd.EtcdInterface, mysqlCli mysql.MysqlInterface) (*service.Uuid, error) {
+	panic(wire.Build(
+		service.NewUuidService,
+		wire.Struct(new(service.Options), "Redis", "Etcd", "Mysql"),
 	))
 }
@@ -8,15 +8,34 @@ package e2e
 
 import (
 	"github.com/douyu/jupiter-examples/uuid/internal/app/uuidserver/service"
+	"github.com/douyu/jupiter-examples/uuid/internal/pkg/etcd"
+	"github.com/douyu/jupiter-examples/uuid/internal/pkg/mysql"
 	"github.com/douyu/jupiter-examples/uuid/internal/pkg/redis"
 )
 
 // Injectors from wire.go:
 
-func CreateUuidService(redisCli redis.RedisInterface) *service.Uuid {
+func CreateUuidService(redisCli redis.RedisInterface, etcdCli etcd.EtcdInterface) (*service.Uuid, error) {
 	options := service.Options{
 		Redis: redisCli,
+		Etcd:  etcdCli,
 	}
-	uuid := service.NewUuidService(options)
-	return uuid
+	uuid, err := service.NewUuidService(options)
+	if err != nil {
+		return nil, err
+	}
+	return uuid, nil
+}
+
+func CreateUuidServiceWithMysql(redisCli redis.RedisInterface, etcdCli etcd.EtcdInterface, mysqlCli mysql.MysqlInterface) (*service.Uuid, error) {
+	options := service.Options{
+		Redis: redisCli,
+		Etcd:  etcdCli,
+		Mysql: mysqlCli,
+	}
+	uuid, err := service.NewUuidService(options)
+	if err != nil {
+		return nil, err
+	}
+	return uuid, nil
 }